@@ -0,0 +1,68 @@
+package cmd
+
+import (
+	"fmt"
+
+	"github.com/mikelorant/committed/internal/commit"
+
+	"github.com/spf13/cobra"
+)
+
+func NewChangelogCmd(a App) *cobra.Command {
+	var opts commit.Options
+
+	cmd := &cobra.Command{
+		Use:   "changelog [revision range]",
+		Short: "Generate a changelog grouped by emoji",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			a.output = output(cmd)
+
+			rev := "HEAD"
+			if len(args) > 0 {
+				rev = args[0]
+			}
+
+			res := Result{Action: actionChangelog}
+
+			opts.SnapshotFile = defaultSnapshotFile
+			opts.DictionaryFile = defaultDictionaryFile
+
+			state, err := a.Commiter.Configure(opts)
+			if err != nil {
+				return a.fail(res, fmt.Errorf("unable to init commit: %w", err))
+			}
+
+			cl, err := a.Commiter.Changelog(commit.NewChangelogRequest(state, rev))
+			if err != nil {
+				return a.fail(res, err)
+			}
+
+			if a.output == OutputJSON {
+				for _, s := range cl.Sections {
+					rs := ResultSection{Heading: s.Heading}
+
+					for _, e := range s.Entries {
+						rs.Commits = append(rs.Commits, ResultEntry{Hash: e.Hash, Summary: e.Summary})
+					}
+
+					res.Sections = append(res.Sections, rs)
+				}
+
+				return writeResult(a.Writer, res, nil)
+			}
+
+			fmt.Fprint(a.Writer, cl.Markdown())
+
+			return nil
+		},
+	}
+
+	cmd.Flags().SortFlags = false
+	cmd.Flags().StringVarP(&opts.ConfigFile, "config", "", defaultConfigFile, "Config file location")
+
+	return cmd
+}
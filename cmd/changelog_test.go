@@ -0,0 +1,187 @@
+package cmd_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/mikelorant/committed/cmd"
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/emoji"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestChangelogCmd(t *testing.T) {
+	type args struct {
+		args      []string
+		configErr error
+		clErr     error
+	}
+
+	type want struct {
+		req    *commit.ChangelogRequest
+		output string
+		err    string
+	}
+
+	set := emoji.New()
+
+	cfg := config.Changelog{Sections: []config.ChangelogSection{
+		{Heading: "Fixes", Emojis: []string{":bug:"}},
+	}}
+
+	cl := commit.Changelog{Sections: []commit.ChangelogSection{
+		{
+			Heading: "Fixes",
+			Entries: []commit.ChangelogEntry{
+				{Hash: "918c48b83bd081e863dbe1b80f8998f058cd8294", Summary: "Fix the parser"},
+			},
+		},
+	}}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "default",
+			want: want{
+				req: &commit.ChangelogRequest{
+					Revision: "HEAD",
+					Emojis:   set,
+					Config:   cfg,
+				},
+				output: "### Fixes\n\n- Fix the parser (918c48b)\n",
+			},
+		},
+		{
+			name: "range",
+			args: args{
+				args: []string{"v1.0.0..v1.1.0"},
+			},
+			want: want{
+				req: &commit.ChangelogRequest{
+					Revision: "v1.0.0..v1.1.0",
+					Emojis:   set,
+					Config:   cfg,
+				},
+				output: "### Fixes\n\n- Fix the parser (918c48b)\n",
+			},
+		},
+		{
+			name: "json",
+			args: args{
+				args: []string{"--output", "json"},
+			},
+			want: want{
+				output: `{
+  "action": "changelog",
+  "status": "success",
+  "sections": [
+    {
+      "heading": "Fixes",
+      "commits": [
+        {
+          "hash": "918c48b83bd081e863dbe1b80f8998f058cd8294",
+          "summary": "Fix the parser"
+        }
+      ]
+    }
+  ]
+}
+`,
+			},
+		},
+		{
+			name: "json_error",
+			args: args{
+				args:  []string{"--output", "json"},
+				clErr: fmt.Errorf("%w: changelog emoji not found: :invalid:", commit.ErrConfig),
+			},
+			want: want{
+				output: `{
+  "action": "changelog",
+  "status": "error",
+  "error": {
+    "code": "config_invalid",
+    "exit": 7,
+    "message": "Invalid config: invalid config: changelog emoji not found: :invalid:"
+  }
+}
+`,
+				err: "Invalid config: invalid config: changelog emoji not found: :invalid:",
+			},
+		},
+		{
+			name: "too_many_args",
+			args: args{
+				args: []string{"v1.0.0..v1.1.0", "HEAD"},
+			},
+			want: want{
+				err: "accepts at most 1 arg(s), received 2",
+			},
+		},
+		{
+			name: "config_error",
+			args: args{
+				configErr: errMock,
+			},
+			want: want{
+				err: "unable to init commit: error",
+			},
+		},
+		{
+			name: "changelog_error",
+			args: args{
+				clErr: errMock,
+			},
+			want: want{
+				err: "error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := commit.State{
+				Emojis: set,
+				Config: config.Config{Changelog: cfg},
+			}
+
+			c := MockCommit{
+				state:     &state,
+				cl:        cl,
+				configErr: tt.args.configErr,
+				clErr:     tt.args.clErr,
+			}
+
+			var buf bytes.Buffer
+
+			root := cmd.NewRootCmd(cmd.App{
+				Commiter: &c,
+				Writer:   &buf,
+			})
+
+			root.SetOut(io.Discard)
+			root.SetErr(io.Discard)
+			root.SetArgs(append([]string{"changelog"}, tt.args.args...))
+
+			err := root.Execute()
+			assert.Equal(t, tt.want.output, buf.String())
+
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			if tt.want.req != nil {
+				assert.Equal(t, tt.want.req, c.changelog)
+			}
+		})
+	}
+}
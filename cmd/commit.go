@@ -0,0 +1,64 @@
+package cmd
+
+import (
+	"github.com/mikelorant/committed/internal/commit"
+
+	"github.com/spf13/cobra"
+)
+
+func NewCommitCmd(a App) *cobra.Command {
+	var (
+		opts commit.Options
+		msg  commit.Message
+	)
+
+	cmd := &cobra.Command{
+		Use:   "commit",
+		Short: "Create a commit without the user interface",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			a.output = output(cmd)
+			opts.Mode = commit.ModeCommit
+			opts.Amend = msg.Amend
+
+			state, err := a.configureState(opts)
+			if err != nil {
+				return err
+			}
+
+			if !cmd.Flags().Changed("signoff") {
+				msg.Signoff = state.Config.Commit.Signoff
+			}
+
+			req, err := commit.NewRequest(state, msg)
+			if err != nil {
+				return a.fail(Result{Action: actionCommit}, err)
+			}
+
+			a.req = req
+
+			return a.apply()
+		},
+	}
+
+	cmd.Flags().SortFlags = false
+	cmd.Flags().StringVarP(&msg.Emoji, "emoji", "e", "", "Emoji character or shortcode")
+	cmd.Flags().StringVarP(&msg.Summary, "summary", "s", "", "Summary of the commit")
+	cmd.Flags().StringVarP(&msg.Body, "body", "b", "", "Body of the commit")
+	cmd.Flags().StringVarP(&msg.Author, "author", "", "", "Author in the format \"Name <email>\"")
+	cmd.Flags().StringVarP(&opts.Identity.AuthorDate, "date", "", "", "Override the author date")
+	cmd.Flags().StringVarP(&opts.Identity.Committer, "committer", "", "", "Committer in the format \"Name <email>\"")
+	cmd.Flags().StringVarP(&opts.Identity.CommitterDate, "committer-date", "", "", "Override the committer date")
+	cmd.Flags().BoolVarP(&msg.Signoff, "signoff", "", false, "Add a Signed-off-by trailer")
+	cmd.Flags().BoolVarP(&msg.Amend, "amend", "a", false, "Replace the tip of the current branch by creating a new commit")
+	cmd.Flags().StringVarP(&opts.ConfigFile, "config", "", defaultConfigFile, "Config file location")
+	cmd.Flags().StringVarP(&opts.SnapshotFile, "snapshot", "", defaultSnapshotFile, "Snapshot file location")
+	cmd.Flags().StringVarP(&opts.DictionaryFile, "dictionary", "", defaultDictionaryFile, "Dictionary file location")
+	cmd.Flags().BoolVarP(&opts.DryRun, "dry-run", "", isDryRun(), "Simulate applying a commit")
+	cmd.MarkFlagRequired("summary")
+
+	return cmd
+}
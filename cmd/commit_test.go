@@ -0,0 +1,222 @@
+package cmd_test
+
+import (
+	"io"
+	"testing"
+
+	"github.com/mikelorant/committed/cmd"
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCommitCmd(t *testing.T) {
+	type args struct {
+		args      []string
+		state     func(s *commit.State)
+		configErr error
+		applyErr  error
+	}
+
+	type want struct {
+		req      *commit.Request
+		amend    bool
+		identity commit.IdentityOptions
+		err      string
+	}
+
+	user := repository.User{Name: "John Doe", Email: "john.doe@example.com"}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "summary",
+			args: args{
+				args: []string{"--summary", "summary"},
+			},
+			want: want{
+				req: &commit.Request{
+					Apply:    true,
+					Summary:  "summary",
+					Author:   user,
+					Headless: true,
+				},
+			},
+		},
+		{
+			name: "all",
+			args: args{
+				args: []string{
+					"--emoji", ":bug:",
+					"--summary", "summary",
+					"--body", "body",
+					"--author", "Jane Doe <jane.doe@example.com>",
+					"--signoff",
+					"--amend",
+				},
+			},
+			want: want{
+				req: &commit.Request{
+					Apply:    true,
+					Emoji:    "🐛",
+					Summary:  "summary",
+					Body:     "body",
+					RawBody:  "body",
+					Footer:   "Signed-off-by: Jane Doe <jane.doe@example.com>",
+					Author:   repository.User{Name: "Jane Doe", Email: "jane.doe@example.com"},
+					Amend:    true,
+					Headless: true,
+				},
+				amend: true,
+			},
+		},
+		{
+			name: "identity",
+			args: args{
+				args: []string{
+					"--summary", "summary",
+					"--date", "2022-01-01T01:00:00Z",
+					"--committer", "Jane Doe <jane.doe@example.com>",
+					"--committer-date", "2022-01-02T01:00:00Z",
+				},
+			},
+			want: want{
+				req: &commit.Request{
+					Apply:    true,
+					Summary:  "summary",
+					Author:   user,
+					Headless: true,
+				},
+				identity: commit.IdentityOptions{
+					AuthorDate:    "2022-01-01T01:00:00Z",
+					Committer:     "Jane Doe <jane.doe@example.com>",
+					CommitterDate: "2022-01-02T01:00:00Z",
+				},
+			},
+		},
+		{
+			name: "signoff_config",
+			args: args{
+				args: []string{"--summary", "summary"},
+				state: func(s *commit.State) {
+					s.Config.Commit.Signoff = true
+				},
+			},
+			want: want{
+				req: &commit.Request{
+					Apply:    true,
+					Summary:  "summary",
+					Footer:   "Signed-off-by: John Doe <john.doe@example.com>",
+					Author:   user,
+					Headless: true,
+				},
+			},
+		},
+		{
+			name: "signoff_config_disabled",
+			args: args{
+				args: []string{"--summary", "summary", "--signoff=false"},
+				state: func(s *commit.State) {
+					s.Config.Commit.Signoff = true
+				},
+			},
+			want: want{
+				req: &commit.Request{
+					Apply:    true,
+					Summary:  "summary",
+					Author:   user,
+					Headless: true,
+				},
+			},
+		},
+		{
+			name: "no_summary",
+			args: args{
+				args: []string{"--emoji", ":bug:"},
+			},
+			want: want{
+				err: `required flag(s) "summary" not set`,
+			},
+		},
+		{
+			name: "invalid_emoji",
+			args: args{
+				args: []string{"--emoji", ":invalid:", "--summary", "summary"},
+			},
+			want: want{
+				err: "Invalid commit message: emoji not found: :invalid:",
+			},
+		},
+		{
+			name: "config_error",
+			args: args{
+				args:      []string{"--summary", "summary"},
+				configErr: errMock,
+			},
+			want: want{
+				err: "unable to init commit: error",
+			},
+		},
+		{
+			name: "apply_error",
+			args: args{
+				args:     []string{"--summary", "summary"},
+				applyErr: errMock,
+			},
+			want: want{
+				err: "error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := commit.State{
+				Emojis: emoji.New(),
+				Repository: repository.Description{
+					Users: []repository.User{user},
+					Worktree: repository.Worktree{
+						Status: git.Status{
+							"main.go": &git.FileStatus{Staging: git.Modified},
+						},
+					},
+				},
+			}
+
+			if tt.args.state != nil {
+				tt.args.state(&state)
+			}
+
+			c := MockCommit{
+				state:     &state,
+				configErr: tt.args.configErr,
+				applyErr:  tt.args.applyErr,
+			}
+
+			cc := cmd.NewCommitCmd(cmd.App{
+				Commiter: &c,
+			})
+
+			cc.SetOut(io.Discard)
+			cc.SetErr(io.Discard)
+			cc.SetArgs(tt.args.args)
+
+			err := cc.Execute()
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, commit.ModeCommit, c.opts.Mode)
+			assert.Equal(t, tt.want.amend, c.opts.Amend)
+			assert.Equal(t, tt.want.identity, c.opts.Identity)
+			assert.Equal(t, tt.want.req, c.req)
+		})
+	}
+}
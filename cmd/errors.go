@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/hook"
+
+	"github.com/go-git/go-git/v5"
+)
+
+// Kind of failure. Each kind has a distinct exit code so that wrappers and
+// the hook can react to the failure.
+type Kind int
+
+// Error is a failure with a friendly message and exit code.
+type Error struct {
+	Kind Kind
+	Err  error
+}
+
+const (
+	KindUnknown Kind = iota
+	KindUsage
+	KindNotRepository
+	KindNotStaged
+	KindHookUnmanaged
+	KindCommitFailed
+	KindConfigInvalid
+	KindMessageInvalid
+	KindRewordInvalid
+)
+
+type failure struct {
+	exit    int
+	code    string
+	message string
+	detail  bool
+}
+
+var failures = map[Kind]failure{
+	KindUnknown:        {exit: 1, code: "error"},
+	KindUsage:          {exit: 2, code: "usage"},
+	KindNotRepository:  {exit: 3, code: "not_repository", message: "No git repository found."},
+	KindNotStaged:      {exit: 4, code: "not_staged", message: "No changes added to commit."},
+	KindHookUnmanaged:  {exit: 5, code: "hook_unmanaged", message: "Hook is not managed by Committed."},
+	KindCommitFailed:   {exit: 6, code: "commit_failed", message: "Git commit failed", detail: true},
+	KindConfigInvalid:  {exit: 7, code: "config_invalid", message: "Invalid config", detail: true},
+	KindMessageInvalid: {exit: 8, code: "message_invalid", message: "Invalid commit message", detail: true},
+	KindRewordInvalid:  {exit: 9, code: "reword_invalid", message: "Unable to reword commit", detail: true},
+}
+
+// kinds of known errors. Errors are matched in order.
+var kinds = []struct {
+	err  error
+	kind Kind
+}{
+	{git.ErrRepositoryNotExists, KindNotRepository},
+	{hook.ErrLocation, KindNotRepository},
+	{commit.ErrStaged, KindNotStaged},
+	{hook.ErrUnmanaged, KindHookUnmanaged},
+	{commit.ErrCommit, KindCommitFailed},
+	{commit.ErrConfig, KindConfigInvalid},
+	{commit.ErrSummary, KindMessageInvalid},
+	{commit.ErrEmoji, KindMessageInvalid},
+	{commit.ErrAuthor, KindMessageInvalid},
+	{commit.ErrReword, KindRewordInvalid},
+	{commit.ErrCommitter, KindUsage},
+	{commit.ErrDate, KindUsage},
+	{ErrOutput, KindUsage},
+}
+
+func (e *Error) Error() string {
+	f := failures[e.Kind]
+
+	switch {
+	case f.message == "":
+		return e.Err.Error()
+	case f.detail:
+		return fmt.Sprintf("%v: %v", f.message, e.Err)
+	default:
+		return f.message
+	}
+}
+
+func (e *Error) Unwrap() error {
+	return e.Err
+}
+
+// ExitCode returns the exit code of the kind of failure.
+func (e *Error) ExitCode() int {
+	return failures[e.Kind].exit
+}
+
+// Code returns the name of the kind of failure.
+func (e *Error) Code() string {
+	return failures[e.Kind].code
+}
+
+// NewError classifies the error by the kind of failure.
+func NewError(err error) *Error {
+	var e *Error
+	if errors.As(err, &e) {
+		return e
+	}
+
+	for _, k := range kinds {
+		if errors.Is(err, k.err) {
+			return &Error{Kind: k.kind, Err: err}
+		}
+	}
+
+	return &Error{Kind: KindUnknown, Err: err}
+}
@@ -0,0 +1,102 @@
+package cmd_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/mikelorant/committed/cmd"
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/hook"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewError(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		kind    cmd.Kind
+		exit    int
+		code    string
+		message string
+	}
+
+	tests := []struct {
+		name string
+		err  error
+		want want
+	}{
+		{
+			name: "unknown",
+			err:  errMock,
+			want: want{kind: cmd.KindUnknown, exit: 1, code: "error", message: "error"},
+		},
+		{
+			name: "usage",
+			err:  &cmd.Error{Kind: cmd.KindUsage, Err: errMock},
+			want: want{kind: cmd.KindUsage, exit: 2, code: "usage", message: "error"},
+		},
+		{
+			name: "not_repository",
+			err:  fmt.Errorf("unable to init commit: %w", git.ErrRepositoryNotExists),
+			want: want{kind: cmd.KindNotRepository, exit: 3, code: "not_repository", message: "No git repository found."},
+		},
+		{
+			name: "hook_location",
+			err:  hook.ErrLocation,
+			want: want{kind: cmd.KindNotRepository, exit: 3, code: "not_repository", message: "No git repository found."},
+		},
+		{
+			name: "not_staged",
+			err:  commit.ErrStaged,
+			want: want{kind: cmd.KindNotStaged, exit: 4, code: "not_staged", message: "No changes added to commit."},
+		},
+		{
+			name: "hook_unmanaged",
+			err:  fmt.Errorf("unable to install or uninstall hook: %w", hook.ErrUnmanaged),
+			want: want{kind: cmd.KindHookUnmanaged, exit: 5, code: "hook_unmanaged", message: "Hook is not managed by Committed."},
+		},
+		{
+			name: "commit_failed",
+			err:  fmt.Errorf("%w: exit status 1", commit.ErrCommit),
+			want: want{kind: cmd.KindCommitFailed, exit: 6, code: "commit_failed", message: "Git commit failed: commit failed: exit status 1"},
+		},
+		{
+			name: "config_invalid",
+			err:  fmt.Errorf("%w: key conflict", commit.ErrConfig),
+			want: want{kind: cmd.KindConfigInvalid, exit: 7, code: "config_invalid", message: "Invalid config: invalid config: key conflict"},
+		},
+		{
+			name: "message_invalid",
+			err:  fmt.Errorf("%w: summary is empty", commit.ErrSummary),
+			want: want{kind: cmd.KindMessageInvalid, exit: 8, code: "message_invalid", message: "Invalid commit message: invalid summary: summary is empty"},
+		},
+		{
+			name: "reword_invalid",
+			err:  fmt.Errorf("%w: %w", commit.ErrReword, commit.ErrDirty),
+			want: want{kind: cmd.KindRewordInvalid, exit: 9, code: "reword_invalid", message: "Unable to reword commit: invalid reword: worktree has changes"},
+		},
+		{
+			name: "output_invalid",
+			err:  fmt.Errorf("%w: xml", cmd.ErrOutput),
+			want: want{kind: cmd.KindUsage, exit: 2, code: "usage", message: "invalid output format: xml"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			e := cmd.NewError(tt.err)
+
+			assert.Equal(t, tt.want.kind, e.Kind)
+			assert.Equal(t, tt.want.exit, e.ExitCode())
+			assert.Equal(t, tt.want.code, e.Code())
+			assert.Equal(t, tt.want.message, e.Error())
+			assert.ErrorIs(t, e, tt.err)
+		})
+	}
+}
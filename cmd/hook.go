@@ -20,15 +20,29 @@ func NewHookCmd(a App) *cobra.Command {
 		Use:   "hook",
 		Short: "Install and uninstall Git hook",
 		Args:  cobra.NoArgs,
-		Run: func(cmd *cobra.Command, args []string) {
+		RunE: func(cmd *cobra.Command, args []string) error {
 			if ok := help(cmd, hookOptions); ok {
-				return
+				return nil
+			}
+
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			a.output = output(cmd)
+
+			res := Result{Action: actionInstall}
+			if hookOptions.Uninstall {
+				res.Action = actionUninstall
 			}
 
 			if err := a.Hooker.Do(hookOptions); err != nil {
-				a.Logger.Fatalf("Unable to install or uninstall hook.")
+				return a.fail(res, fmt.Errorf("unable to install or uninstall hook: %w", err))
+			}
+
+			if a.output == OutputJSON {
+				res.Paths = []string{a.Hooker.Path()}
 
-				return
+				return writeResult(a.Writer, res, nil)
 			}
 
 			switch {
@@ -37,6 +51,8 @@ func NewHookCmd(a App) *cobra.Command {
 			case hookOptions.Uninstall:
 				fmt.Fprintln(a.Writer, hookUninstallSuccess)
 			}
+
+			return nil
 		},
 	}
 
@@ -19,6 +19,10 @@ type MockHook struct {
 	err  error
 }
 
+func (h *MockHook) Path() string {
+	return "/repo/.git/hooks/prepare-commit-msg"
+}
+
 func (h *MockHook) Do(opts hook.Options) error {
 	h.opts = opts
 
@@ -101,15 +105,12 @@ func TestHookCmd(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			var buf bytes.Buffer
 
-			mlog := NewMockLogger(&buf)
-
 			h := MockHook{
 				err: tt.args.err,
 			}
 
 			a := cmd.App{
 				Hooker: &h,
-				Logger: mlog,
 				Writer: &buf,
 			}
 
@@ -119,10 +120,11 @@ func TestHookCmd(t *testing.T) {
 			hook.SetErr(&buf)
 			hook.SetArgs(tt.args.args)
 
-			hook.Execute()
+			err := hook.Execute()
 			if tt.want.err != "" {
 				assert.Error(t, h.err)
 				assert.ErrorContains(t, h.err, tt.want.err)
+				assert.EqualError(t, err, "unable to install or uninstall hook: "+tt.want.err)
 				output := stripString(buf.String())
 				autogold.ExpectFile(t, autogold.Raw(output), autogold.Name(tt.name))
 				return
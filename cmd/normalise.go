@@ -0,0 +1,116 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+
+	"github.com/mikelorant/committed/internal/commit"
+
+	"github.com/spf13/cobra"
+)
+
+const (
+	normaliseNone      = "✅ Emojis are consistent."
+	normaliseRewritten = "✅ Commits normalised: %d."
+	normaliseSkipped   = " (skipped, pushed)"
+)
+
+func NewNormaliseCmd(a App) *cobra.Command {
+	var (
+		opts    commit.Options
+		rewrite bool
+	)
+
+	cmd := &cobra.Command{
+		Use:   "normalise [revision range]",
+		Short: "Normalise the emoji form of commit subjects",
+		Args:  cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
+			a.output = output(cmd)
+
+			// The commits not yet pushed are normalised by default.
+			var rev string
+			if len(args) > 0 {
+				rev = args[0]
+			}
+
+			res := Result{Action: actionNormalise}
+
+			opts.SnapshotFile = defaultSnapshotFile
+			opts.DictionaryFile = defaultDictionaryFile
+
+			state, err := a.Commiter.Configure(opts)
+			if err != nil {
+				return a.fail(res, fmt.Errorf("unable to init commit: %w", err))
+			}
+
+			req := commit.NewNormaliseRequest(state, rev, rewrite)
+			if a.output == OutputJSON {
+				req.Output = os.Stderr
+			}
+
+			ns, err := a.Commiter.Normalise(req)
+			if err != nil {
+				return a.fail(res, err)
+			}
+
+			var count int
+
+			for _, n := range ns {
+				if !n.Skipped {
+					count++
+				}
+			}
+
+			rewritten := rewrite && !req.DryRun && count > 0
+
+			if rewritten {
+				if head, err := a.Commiter.Head(); err == nil {
+					res.Hash = head.Hash
+				}
+			}
+
+			if a.output == OutputJSON {
+				for _, n := range ns {
+					res.Commits = append(res.Commits, ResultCommit{
+						Hash:       n.Commit.Hash,
+						Subject:    commit.MessageToSubject(n.Commit.Message),
+						Normalised: commit.MessageToSubject(n.Message),
+						Skipped:    n.Skipped,
+					})
+				}
+
+				return writeResult(a.Writer, res, nil)
+			}
+
+			for _, n := range ns {
+				var skipped string
+				if n.Skipped {
+					skipped = normaliseSkipped
+				}
+
+				fmt.Fprintf(a.Writer, "%.7s %s → %s%s\n", n.Commit.Hash,
+					commit.MessageToSubject(n.Commit.Message), commit.MessageToSubject(n.Message), skipped)
+			}
+
+			switch {
+			case len(ns) == 0:
+				fmt.Fprintln(a.Writer, normaliseNone)
+			case rewritten:
+				fmt.Fprintf(a.Writer, normaliseRewritten+"\n", count)
+			}
+
+			return nil
+		},
+	}
+
+	cmd.Flags().SortFlags = false
+	cmd.Flags().BoolVarP(&rewrite, "rewrite", "", false, "Rewrite the commits of the current branch")
+	cmd.Flags().StringVarP(&opts.ConfigFile, "config", "", defaultConfigFile, "Config file location")
+	cmd.Flags().BoolVarP(&opts.DryRun, "dry-run", "", isDryRun(), "Simulate rewriting the commits")
+
+	return cmd
+}
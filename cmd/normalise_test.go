@@ -0,0 +1,306 @@
+package cmd_test
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"testing"
+
+	"github.com/mikelorant/committed/cmd"
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormaliseCmd(t *testing.T) {
+	type args struct {
+		args       []string
+		dryRun     bool
+		normalised []commit.Normalised
+		configErr  error
+		normErr    error
+	}
+
+	type want struct {
+		req    *commit.NormaliseRequest
+		output string
+		err    string
+	}
+
+	set := emoji.New()
+	user := repository.User{Name: "John Doe", Email: "john.doe@example.com"}
+
+	normalised := []commit.Normalised{
+		{
+			Commit: repository.Head{
+				Hash:    "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				Message: ":bug: Fix the parser\n",
+			},
+			Message: "🐛 Fix the parser\n",
+		},
+	}
+
+	skipped := []commit.Normalised{
+		{
+			Commit: repository.Head{
+				Hash:    "af2d6a6954d532f8ffb47615169c8fdf9d383a1a",
+				Message: ":art: Add the model\n",
+			},
+			Message: "🎨 Add the model\n",
+			Skipped: true,
+		},
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "default",
+			args: args{
+				normalised: normalised,
+			},
+			want: want{
+				req: &commit.NormaliseRequest{
+					Emojis: set,
+					Author: user,
+					Clean:  true,
+				},
+				output: "918c48b :bug: Fix the parser → 🐛 Fix the parser\n",
+			},
+		},
+		{
+			name: "consistent",
+			args: args{
+				args: []string{"HEAD~2.."},
+			},
+			want: want{
+				req: &commit.NormaliseRequest{
+					Revision: "HEAD~2..",
+					Emojis:   set,
+					Author:   user,
+					Clean:    true,
+				},
+				output: "✅ Emojis are consistent.\n",
+			},
+		},
+		{
+			name: "rewrite",
+			args: args{
+				args:       []string{"--rewrite", "HEAD~2.."},
+				normalised: normalised,
+			},
+			want: want{
+				req: &commit.NormaliseRequest{
+					Revision: "HEAD~2..",
+					Emojis:   set,
+					Author:   user,
+					Rewrite:  true,
+					Clean:    true,
+				},
+				output: "918c48b :bug: Fix the parser → 🐛 Fix the parser\n✅ Commits normalised: 1.\n",
+			},
+		},
+		{
+			name: "rewrite_skipped",
+			args: args{
+				args:       []string{"--rewrite", "HEAD"},
+				normalised: append(normalised, skipped...),
+			},
+			want: want{
+				output: "918c48b :bug: Fix the parser → 🐛 Fix the parser\n" +
+					"af2d6a6 :art: Add the model → 🎨 Add the model (skipped, pushed)\n" +
+					"✅ Commits normalised: 1.\n",
+			},
+		},
+		{
+			name: "rewrite_all_skipped",
+			args: args{
+				args:       []string{"--rewrite", "HEAD"},
+				normalised: skipped,
+			},
+			want: want{
+				output: "af2d6a6 :art: Add the model → 🎨 Add the model (skipped, pushed)\n",
+			},
+		},
+		{
+			name: "rewrite_dry_run",
+			args: args{
+				args:       []string{"--rewrite", "--dry-run"},
+				dryRun:     true,
+				normalised: normalised,
+			},
+			want: want{
+				req: &commit.NormaliseRequest{
+					Emojis:  set,
+					Author:  user,
+					Rewrite: true,
+					Clean:   true,
+					DryRun:  true,
+				},
+				output: "918c48b :bug: Fix the parser → 🐛 Fix the parser\n",
+			},
+		},
+		{
+			name: "json",
+			args: args{
+				args:       []string{"--output", "json"},
+				normalised: normalised,
+			},
+			want: want{
+				output: `{
+  "action": "normalise",
+  "status": "success",
+  "commits": [
+    {
+      "hash": "918c48b83bd081e863dbe1b80f8998f058cd8294",
+      "subject": ":bug: Fix the parser",
+      "normalised": "🐛 Fix the parser"
+    }
+  ]
+}
+`,
+			},
+		},
+		{
+			name: "json_rewrite",
+			args: args{
+				args:       []string{"--output", "json", "--rewrite"},
+				normalised: normalised,
+			},
+			want: want{
+				output: `{
+  "action": "normalise",
+  "status": "success",
+  "hash": "6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+  "commits": [
+    {
+      "hash": "918c48b83bd081e863dbe1b80f8998f058cd8294",
+      "subject": ":bug: Fix the parser",
+      "normalised": "🐛 Fix the parser"
+    }
+  ]
+}
+`,
+			},
+		},
+		{
+			name: "json_skipped",
+			args: args{
+				args:       []string{"--output", "json", "--rewrite", "HEAD"},
+				normalised: skipped,
+			},
+			want: want{
+				output: `{
+  "action": "normalise",
+  "status": "success",
+  "commits": [
+    {
+      "hash": "af2d6a6954d532f8ffb47615169c8fdf9d383a1a",
+      "subject": ":art: Add the model",
+      "normalised": "🎨 Add the model",
+      "skipped": true
+    }
+  ]
+}
+`,
+			},
+		},
+		{
+			name: "json_error",
+			args: args{
+				args:    []string{"--output", "json", "--rewrite"},
+				normErr: fmt.Errorf("%w: %w", commit.ErrReword, commit.ErrDirty),
+			},
+			want: want{
+				output: `{
+  "action": "normalise",
+  "status": "error",
+  "error": {
+    "code": "reword_invalid",
+    "exit": 9,
+    "message": "Unable to reword commit: invalid reword: worktree has changes"
+  }
+}
+`,
+				err: "Unable to reword commit: invalid reword: worktree has changes",
+			},
+		},
+		{
+			name: "too_many_args",
+			args: args{
+				args: []string{"HEAD~2..", "HEAD"},
+			},
+			want: want{
+				err: "accepts at most 1 arg(s), received 2",
+			},
+		},
+		{
+			name: "config_error",
+			args: args{
+				configErr: errMock,
+			},
+			want: want{
+				err: "unable to init commit: error",
+			},
+		},
+		{
+			name: "normalise_error",
+			args: args{
+				normErr: errMock,
+			},
+			want: want{
+				err: "error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := commit.State{
+				Emojis: set,
+				Config: config.Config{},
+				Repository: repository.Description{
+					Users: []repository.User{user},
+				},
+				Options: commit.Options{DryRun: tt.args.dryRun},
+			}
+
+			c := MockCommit{
+				state:      &state,
+				head:       repository.Head{Hash: "6ecf0ef2c2dffb796033e5a02219af86ec6584e5"},
+				normalised: tt.args.normalised,
+				configErr:  tt.args.configErr,
+				normErr:    tt.args.normErr,
+			}
+
+			var buf bytes.Buffer
+
+			root := cmd.NewRootCmd(cmd.App{
+				Commiter: &c,
+				Writer:   &buf,
+			})
+
+			root.SetOut(io.Discard)
+			root.SetErr(io.Discard)
+			root.SetArgs(append([]string{"normalise"}, tt.args.args...))
+
+			err := root.Execute()
+			assert.Equal(t, tt.want.output, buf.String())
+
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			if tt.want.req != nil {
+				assert.Equal(t, tt.want.req, c.norm)
+			}
+		})
+	}
+}
@@ -0,0 +1,119 @@
+package cmd
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"github.com/spf13/cobra"
+)
+
+// Result is the outcome of a command when the output is JSON.
+type Result struct {
+	Action   string            `json:"action"`
+	Status   string            `json:"status"`
+	Hash     string            `json:"hash,omitempty"`
+	Paths    []string          `json:"paths,omitempty"`
+	Version  map[string]string `json:"version,omitempty"`
+	Commits  []ResultCommit    `json:"commits,omitempty"`
+	Sections []ResultSection   `json:"sections,omitempty"`
+	Error    *ResultError      `json:"error,omitempty"`
+}
+
+// ResultCommit is a commit with the subject before and after normalising.
+// Skipped commits were not rewritten as they have been pushed.
+type ResultCommit struct {
+	Hash       string `json:"hash"`
+	Subject    string `json:"subject"`
+	Normalised string `json:"normalised"`
+	Skipped    bool   `json:"skipped,omitempty"`
+}
+
+// ResultSection is a section of the changelog.
+type ResultSection struct {
+	Heading string        `json:"heading"`
+	Commits []ResultEntry `json:"commits"`
+}
+
+// ResultEntry is a commit of the changelog.
+type ResultEntry struct {
+	Hash    string `json:"hash"`
+	Summary string `json:"summary"`
+}
+
+type ResultError struct {
+	Code    string `json:"code"`
+	Exit    int    `json:"exit"`
+	Message string `json:"message"`
+}
+
+const (
+	OutputText = "text"
+	OutputJSON = "json"
+
+	StatusSuccess   = "success"
+	StatusError     = "error"
+	StatusCancelled = "cancelled"
+
+	actionCommit    = "commit"
+	actionAmend     = "amend"
+	actionReword    = "reword"
+	actionNormalise = "normalise"
+	actionChangelog = "changelog"
+	actionInstall   = "install"
+	actionUninstall = "uninstall"
+	actionVersion   = "version"
+)
+
+var ErrOutput = errors.New("invalid output format")
+
+// output returns the output format set by the global flag.
+func output(cmd *cobra.Command) string {
+	f := cmd.Flags().Lookup("output")
+	if f == nil {
+		return OutputText
+	}
+
+	return f.Value.String()
+}
+
+func isJSON(cmd *cobra.Command) bool {
+	return output(cmd) == OutputJSON
+}
+
+func validateOutput(str string) error {
+	switch str {
+	case OutputText, OutputJSON:
+		return nil
+	}
+
+	return fmt.Errorf("%w: %v", ErrOutput, str)
+}
+
+// writeResult writes the result as JSON, setting the error when not nil.
+func writeResult(w io.Writer, res Result, err error) error {
+	if res.Status == "" {
+		res.Status = StatusSuccess
+	}
+
+	if err != nil {
+		e := NewError(err)
+
+		res.Status = StatusError
+		res.Error = &ResultError{
+			Code:    e.Code(),
+			Exit:    e.ExitCode(),
+			Message: e.Error(),
+		}
+	}
+
+	enc := json.NewEncoder(w)
+	enc.SetIndent("", "  ")
+
+	if err := enc.Encode(res); err != nil {
+		return fmt.Errorf("unable to encode result: %w", err)
+	}
+
+	return nil
+}
@@ -0,0 +1,181 @@
+package cmd_test
+
+import (
+	"bytes"
+	"io"
+	"testing"
+
+	"github.com/mikelorant/committed/cmd"
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/hook"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestOutput(t *testing.T) {
+	type args struct {
+		args      []string
+		configErr error
+		applyErr  error
+		hookErr   error
+		dryRun    bool
+	}
+
+	type want struct {
+		err bool
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "output_version",
+			args: args{
+				args: []string{"--output", "json", "version"},
+			},
+		},
+		{
+			name: "output_hook_install",
+			args: args{
+				args: []string{"--output", "json", "hook", "--install"},
+			},
+		},
+		{
+			name: "output_hook_uninstall",
+			args: args{
+				args: []string{"--output", "json", "hook", "--uninstall"},
+			},
+		},
+		{
+			name: "output_hook_error",
+			args: args{
+				args:    []string{"--output", "json", "hook", "--install"},
+				hookErr: hook.ErrUnmanaged,
+			},
+			want: want{
+				err: true,
+			},
+		},
+		{
+			name: "output_commit",
+			args: args{
+				args: []string{"--output", "json", "commit", "--summary", "summary"},
+			},
+		},
+		{
+			name: "output_commit_amend",
+			args: args{
+				args: []string{"-o", "json", "commit", "--summary", "summary", "--amend"},
+			},
+		},
+		{
+			name: "output_commit_dry_run",
+			args: args{
+				args:   []string{"--output", "json", "commit", "--summary", "summary"},
+				dryRun: true,
+			},
+		},
+		{
+			name: "output_commit_invalid",
+			args: args{
+				args: []string{"--output", "json", "commit", "--summary", "summary", "--emoji", ":invalid:"},
+			},
+			want: want{
+				err: true,
+			},
+		},
+		{
+			name: "output_commit_repository_error",
+			args: args{
+				args:      []string{"--output", "json", "commit", "--summary", "summary"},
+				configErr: git.ErrRepositoryNotExists,
+			},
+			want: want{
+				err: true,
+			},
+		},
+		{
+			name: "output_commit_apply_error",
+			args: args{
+				args:     []string{"--output", "json", "commit", "--summary", "summary"},
+				applyErr: errMock,
+			},
+			want: want{
+				err: true,
+			},
+		},
+		{
+			name: "output_cancelled",
+			args: args{
+				args: []string{"--output", "json"},
+			},
+		},
+		{
+			name: "output_invalid",
+			args: args{
+				args: []string{"--output", "xml", "version"},
+			},
+			want: want{
+				err: true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			state := commit.State{
+				Emojis: emoji.New(),
+				Options: commit.Options{
+					DryRun: tt.args.dryRun,
+				},
+				Repository: repository.Description{
+					Users: []repository.User{
+						{Name: "John Doe", Email: "john.doe@example.com"},
+					},
+					Worktree: repository.Worktree{
+						Status: git.Status{
+							"main.go":  &git.FileStatus{Staging: git.Modified},
+							"go.mod":   &git.FileStatus{Staging: git.Modified},
+							"draft.go": &git.FileStatus{Staging: git.Untracked, Worktree: git.Untracked},
+						},
+					},
+				},
+			}
+
+			root := cmd.NewRootCmd(cmd.App{
+				Commiter: &MockCommit{
+					head:      repository.Head{Hash: "1234567890abcdef1234567890abcdef12345678"},
+					state:     &state,
+					configErr: tt.args.configErr,
+					applyErr:  tt.args.applyErr,
+				},
+				Hooker: &MockHook{
+					err: tt.args.hookErr,
+				},
+				UIer:   &MockUI{},
+				Writer: &buf,
+			})
+
+			root.SetOut(&buf)
+			root.SetErr(io.Discard)
+			root.SetArgs(tt.args.args)
+
+			err := root.Execute()
+			if tt.want.err {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+
+			autogold.ExpectFile(t, autogold.Raw(stripString(buf.String())), autogold.Name(tt.name))
+		})
+	}
+}
@@ -1,17 +1,17 @@
 package cmd
 
 import (
-	"errors"
 	"fmt"
 	"io"
-	"log"
 	"os"
 
 	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/hook"
+	"github.com/mikelorant/committed/internal/repository"
 	"github.com/mikelorant/committed/internal/ui"
 
-	"github.com/go-git/go-git/v5"
+	"github.com/charmbracelet/x/term"
 	cc "github.com/ivanpirog/coloredcobra"
 	"github.com/spf13/cobra"
 )
@@ -19,6 +19,9 @@ import (
 type Commiter interface {
 	Configure(opts commit.Options) (*commit.State, error)
 	Apply(req *commit.Request) error
+	Head() (repository.Head, error)
+	Normalise(req *commit.NormaliseRequest) ([]commit.Normalised, error)
+	Changelog(req *commit.ChangelogRequest) (commit.Changelog, error)
 }
 
 type UIer interface {
@@ -26,37 +29,48 @@ type UIer interface {
 	Start() (*commit.Request, error)
 }
 
-type Logger interface {
-	Fatalf(format string, v ...any)
-}
-
 type Hooker interface {
 	Do(opts hook.Options) error
+	Path() string
 }
 
 type App struct {
 	Commiter Commiter
 	UIer     UIer
-	Logger   Logger
 	Writer   io.Writer
 	Hooker   Hooker
+	Terminal func() bool
 
-	req  *commit.Request
-	opts commit.Options
-	hook bool
+	req    *commit.Request
+	state  *commit.State
+	opts   commit.Options
+	hook   bool
+	output string
 }
 
 type Options struct {
 	Hook bool
 }
 
+const (
+	defaultConfigFile     = "$HOME/.config/committed/config.yaml"
+	defaultSnapshotFile   = "$HOME/.local/state/committed/snapshot.yaml"
+	defaultDictionaryFile = "$HOME/.config/committed/dictionary.txt"
+)
+
 func NewRootCmd(a App) *cobra.Command {
 	cmd := &cobra.Command{
 		Use:         "committed",
 		Short:       "Committed is a WYSIWYG Git commit editor",
 		Version:     version,
 		Annotations: annotations(),
+		PersistentPreRunE: func(cmd *cobra.Command, args []string) error {
+			return validateOutput(output(cmd))
+		},
 		PreRunE: func(cmd *cobra.Command, args []string) error {
+			cmd.SilenceUsage = true
+			cmd.SilenceErrors = true
+
 			return a.configure(a.opts)
 		},
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -67,25 +81,34 @@ func NewRootCmd(a App) *cobra.Command {
 		},
 	}
 
-	var (
-		defaultDryRun       = isDryRun()
-		defaultConfigFile   = "$HOME/.config/committed/config.yaml"
-		defaultSnapshotFile = "$HOME/.local/state/committed/snapshot.yaml"
-	)
+	defaultDryRun := isDryRun()
 
 	cmd.AddCommand(NewVersionCmd())
 	cmd.AddCommand(NewHookCmd(a))
+	cmd.AddCommand(NewCommitCmd(a))
+	cmd.AddCommand(NewNormaliseCmd(a))
+	cmd.AddCommand(NewChangelogCmd(a))
 	cmd.SetVersionTemplate(verTmpl)
+	cmd.SetFlagErrorFunc(func(cmd *cobra.Command, err error) error {
+		return &Error{Kind: KindUsage, Err: err}
+	})
+	cmd.PersistentFlags().StringVarP(&a.output, "output", "o", OutputText, "Output format (text, json)")
 	cmd.Flags().SortFlags = false
 	cmd.Flags().StringVarP(&a.opts.ConfigFile, "config", "", defaultConfigFile, "Config file location")
 	cmd.Flags().StringVarP(&a.opts.SnapshotFile, "snapshot", "", defaultSnapshotFile, "Snapshot file location")
+	cmd.Flags().StringVarP(&a.opts.DictionaryFile, "dictionary", "", defaultDictionaryFile, "Dictionary file location")
 	cmd.Flags().BoolVarP(&a.opts.DryRun, "dry-run", "", defaultDryRun, "Simulate applying a commit")
 	cmd.Flags().BoolVarP(&a.opts.Amend, "amend", "a", false, "Replace the tip of the current branch by creating a new commit")
+	cmd.Flags().StringVarP(&a.opts.Reword, "reword", "", "", "Reword an earlier commit of the current branch")
+	cmd.Flags().StringVarP(&a.opts.Identity.AuthorDate, "date", "", "", "Override the author date")
+	cmd.Flags().StringVarP(&a.opts.Identity.Committer, "committer", "", "", "Committer in the format \"Name <email>\"")
+	cmd.Flags().StringVarP(&a.opts.Identity.CommitterDate, "committer-date", "", "", "Override the committer date")
 	cmd.Flags().StringVarP(&a.opts.File.MessageFile, "editor", "", "", "")
 	cmd.Flags().BoolVarP(&a.hook, "hook", "", false, "")
 	cmd.Flags().StringVarP(&a.opts.File.MessageFile, "message-file", "", "", "")
 	cmd.Flags().StringVarP(&a.opts.File.Source, "source", "", "", "")
 	cmd.Flags().StringVarP(&a.opts.File.SHA, "sha", "", "", "")
+	cmd.MarkFlagsMutuallyExclusive("amend", "reword")
 	cmd.Flags().MarkHidden("editor")
 	cmd.Flags().MarkHidden("hook")
 	cmd.Flags().MarkHidden("message-file")
@@ -108,51 +131,66 @@ func NewRootCmd(a App) *cobra.Command {
 
 func Execute() {
 	if err := NewRootCmd(NewApp()).Execute(); err != nil {
-		fmt.Fprintf(os.Stderr, "error: %v\n", err)
-		os.Exit(1)
+		e := NewError(err)
+
+		fmt.Fprintf(os.Stderr, "error: %v\n", e)
+		os.Exit(e.ExitCode())
 	}
 }
 
 func NewApp() App {
 	c := commit.New()
 	h := hook.New()
-	l := log.Default()
 	u := ui.New()
 	w := os.Stdout
 
 	return App{
 		Commiter: &c,
 		Hooker:   &h,
-		Logger:   l,
 		UIer:     &u,
 		Writer:   w,
+		Terminal: func() bool {
+			return term.IsTerminal(os.Stdin.Fd())
+		},
 	}
 }
 
 func (a *App) configure(opts commit.Options) error {
 	opts.Mode = a.mode()
 
+	state, err := a.configureState(opts)
+	if err != nil {
+		return err
+	}
+
+	a.UIer.Configure(state)
+
+	return nil
+}
+
+func (a *App) configureState(opts commit.Options) (*commit.State, error) {
 	state, err := a.Commiter.Configure(opts)
 	switch {
 	case err == nil:
-	case errors.Is(err, git.ErrRepositoryNotExists):
-		a.Logger.Fatalf("No git repository found.")
-		return err
 	default:
-		a.Logger.Fatalf("unable to init commit: %v", err)
-		return err
+		return nil, a.fail(Result{Action: actionCommit}, fmt.Errorf("unable to init commit: %w", err))
 	}
 
-	a.UIer.Configure(state)
+	a.state = state
 
-	return nil
+	return state, nil
 }
 
 func (a *App) start() error {
+	if a.hook && !a.isTerminal() {
+		a.req = a.fallback()
+
+		return nil
+	}
+
 	r, err := a.UIer.Start()
 	if err != nil {
-		a.Logger.Fatalf("unable to start ui: %v", err)
-		return err
+		return a.fail(Result{Action: actionCommit}, fmt.Errorf("unable to start ui: %w", err))
 	}
 	a.req = r
 
@@ -160,14 +198,84 @@ func (a *App) start() error {
 }
 
 func (a *App) apply() error {
+	if a.req != nil && a.output == OutputJSON {
+		a.req.Output = os.Stderr
+	}
+
 	if err := a.Commiter.Apply(a.req); err != nil {
-		a.Logger.Fatalf("unable to apply commit: %v", err)
-		return err
+		return a.fail(a.commitResult(false), err)
+	}
+
+	if a.output == OutputJSON {
+		return writeResult(a.Writer, a.commitResult(true), nil)
 	}
 
 	return nil
 }
 
+// fail classifies the error by the kind of failure and writes it as a
+// result when the output is JSON.
+func (a *App) fail(res Result, err error) error {
+	e := NewError(err)
+
+	if a.output == OutputJSON {
+		writeResult(a.Writer, res, e)
+	}
+
+	return e
+}
+
+// commitResult describes the commit of the request. Commits that failed or
+// were simulated have no hash.
+func (a *App) commitResult(applied bool) Result {
+	res := Result{
+		Action: actionCommit,
+	}
+
+	if a.req == nil || !a.req.Apply {
+		res.Status = StatusCancelled
+
+		return res
+	}
+
+	switch {
+	case a.req.Amend:
+		res.Action = actionAmend
+	case a.req.Reword:
+		res.Action = actionReword
+	}
+
+	if a.state != nil {
+		res.Paths = a.state.Repository.Worktree.Staged()
+	}
+
+	if !applied || a.req.DryRun || a.req.File {
+		return res
+	}
+
+	if head, err := a.Commiter.Head(); err == nil {
+		res.Hash = head.Hash
+	}
+
+	return res
+}
+
+// isTerminal reports whether the user interface can be started. A terminal
+// is assumed when there is no check.
+func (a *App) isTerminal() bool {
+	return a.Terminal == nil || a.Terminal()
+}
+
+// fallback returns the request of the hook when there is no terminal. The
+// message is left untouched unless the config applies it.
+func (a *App) fallback() *commit.Request {
+	if a.state == nil || a.state.Config.Commit.HookFallback != config.HookFallbackApply {
+		return nil
+	}
+
+	return commit.NewFileRequest(a.state)
+}
+
 func (a *App) mode() commit.Mode {
 	switch {
 	case !a.hook && a.opts.File.MessageFile != "":
@@ -3,14 +3,17 @@ package cmd_test
 import (
 	"bytes"
 	"errors"
+	"fmt"
 	"io"
-	"log"
 	"strings"
 	"testing"
 	"unicode"
 
 	"github.com/mikelorant/committed/cmd"
 	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/repository"
 
 	"github.com/acarl005/stripansi"
 	"github.com/go-git/go-git/v5"
@@ -21,35 +24,50 @@ import (
 )
 
 type MockCommit struct {
-	configErr error
-	applyErr  error
+	head       repository.Head
+	state      *commit.State
+	req        *commit.Request
+	opts       commit.Options
+	norm       *commit.NormaliseRequest
+	normalised []commit.Normalised
+	changelog  *commit.ChangelogRequest
+	cl         commit.Changelog
+	configErr  error
+	applyErr   error
+	normErr    error
+	clErr      error
 }
 
 type MockUI struct {
 	err error
 }
 
-type MockLogger struct {
-	logger *log.Logger
-	rw     io.ReadWriter
-}
+func (m *MockCommit) Configure(opts commit.Options) (*commit.State, error) {
+	m.opts = opts
 
-func (m MockLogger) Fatalf(format string, v ...any) {
-	m.logger.Printf(format, v...)
+	return m.state, m.configErr
 }
 
-func (m MockLogger) String() string {
-	out, _ := io.ReadAll(m.rw)
+func (m *MockCommit) Apply(req *commit.Request) error {
+	m.req = req
 
-	return strings.TrimSpace(string(out))
+	return m.applyErr
 }
 
-func (m *MockCommit) Configure(opts commit.Options) (*commit.State, error) {
-	return nil, m.configErr
+func (m *MockCommit) Head() (repository.Head, error) {
+	return m.head, nil
 }
 
-func (m *MockCommit) Apply(req *commit.Request) error {
-	return m.applyErr
+func (m *MockCommit) Normalise(req *commit.NormaliseRequest) ([]commit.Normalised, error) {
+	m.norm = req
+
+	return m.normalised, m.normErr
+}
+
+func (m *MockCommit) Changelog(req *commit.ChangelogRequest) (commit.Changelog, error) {
+	m.changelog = req
+
+	return m.cl, m.clErr
 }
 
 func (m *MockUI) Configure(cfg *commit.State) {}
@@ -60,13 +78,6 @@ func (m *MockUI) Start() (*commit.Request, error) {
 
 var errMock = errors.New("error")
 
-func NewMockLogger(rw io.ReadWriter) MockLogger {
-	return MockLogger{
-		rw:     rw,
-		logger: log.New(rw, "", 0),
-	}
-}
-
 func TestNewRootCmd(t *testing.T) {
 	type args struct {
 		configErr error
@@ -75,7 +86,8 @@ func TestNewRootCmd(t *testing.T) {
 	}
 
 	type want struct {
-		err string
+		err  string
+		exit int
 	}
 
 	tests := []struct {
@@ -92,7 +104,18 @@ func TestNewRootCmd(t *testing.T) {
 				configErr: errMock,
 			},
 			want: want{
-				err: "unable to init commit: error",
+				err:  "unable to init commit: error",
+				exit: 1,
+			},
+		},
+		{
+			name: "config_invalid",
+			args: args{
+				configErr: fmt.Errorf("%w: unable to get config: %w", commit.ErrConfig, errMock),
+			},
+			want: want{
+				err:  "Invalid config: unable to init commit: invalid config: unable to get config: error",
+				exit: 7,
 			},
 		},
 		{
@@ -101,7 +124,8 @@ func TestNewRootCmd(t *testing.T) {
 				configErr: git.ErrRepositoryNotExists,
 			},
 			want: want{
-				err: "No git repository found.",
+				err:  "No git repository found.",
+				exit: 3,
 			},
 		},
 		{
@@ -110,7 +134,8 @@ func TestNewRootCmd(t *testing.T) {
 				startErr: errMock,
 			},
 			want: want{
-				err: "unable to start ui: error",
+				err:  "unable to start ui: error",
+				exit: 1,
 			},
 		},
 		{
@@ -119,17 +144,24 @@ func TestNewRootCmd(t *testing.T) {
 				applyErr: errMock,
 			},
 			want: want{
-				err: "unable to apply commit: error",
+				err:  "error",
+				exit: 1,
+			},
+		},
+		{
+			name: "commit_failed",
+			args: args{
+				applyErr: fmt.Errorf("%w: unable to apply commit: %w", commit.ErrCommit, errMock),
+			},
+			want: want{
+				err:  "Git commit failed: commit failed: unable to apply commit: error",
+				exit: 6,
 			},
 		},
 	}
 
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
-			var buf bytes.Buffer
-
-			mlog := NewMockLogger(&buf)
-
 			root := cmd.NewRootCmd(cmd.App{
 				Commiter: &MockCommit{
 					configErr: tt.args.configErr,
@@ -138,16 +170,16 @@ func TestNewRootCmd(t *testing.T) {
 				UIer: &MockUI{
 					err: tt.args.startErr,
 				},
-				Logger: mlog,
 			})
 
 			root.SetOut(io.Discard)
 			root.SetErr(io.Discard)
+			root.SetArgs([]string{})
 
 			err := root.Execute()
 			if tt.want.err != "" {
-				assert.NotNil(t, err)
-				assert.Equal(t, tt.want.err, mlog.String())
+				assert.EqualError(t, err, tt.want.err)
+				assert.Equal(t, tt.want.exit, cmd.NewError(err).ExitCode())
 				return
 			}
 			assert.Nil(t, err)
@@ -155,6 +187,72 @@ func TestNewRootCmd(t *testing.T) {
 	}
 }
 
+func TestNewRootCmdNoTerminal(t *testing.T) {
+	tests := []struct {
+		name     string
+		fallback config.HookFallback
+		message  string
+		req      *commit.Request
+	}{
+		{
+			name:    "unset",
+			message: "summary",
+		},
+		{
+			name:     "keep",
+			fallback: config.HookFallbackKeep,
+			message:  "summary",
+		},
+		{
+			name:     "apply",
+			fallback: config.HookFallbackApply,
+			message:  "summary",
+			req: &commit.Request{
+				Apply:       true,
+				Summary:     "summary",
+				File:        true,
+				MessageFile: "COMMIT_EDITMSG",
+				Headless:    true,
+			},
+		},
+		{
+			name:     "apply_empty",
+			fallback: config.HookFallbackApply,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			state := &commit.State{
+				Emojis: emoji.New(),
+				Config: config.Config{
+					Commit: config.Commit{HookFallback: tt.fallback},
+				},
+				File: commit.File{Message: tt.message},
+				Options: commit.Options{
+					File: commit.FileOptions{MessageFile: "COMMIT_EDITMSG"},
+				},
+			}
+
+			c := &MockCommit{state: state}
+
+			root := cmd.NewRootCmd(cmd.App{
+				Commiter: c,
+				UIer:     &MockUI{err: errMock},
+				Terminal: func() bool { return false },
+			})
+
+			root.SetOut(io.Discard)
+			root.SetErr(io.Discard)
+			root.SetArgs([]string{"--hook", "--message-file", "COMMIT_EDITMSG"})
+
+			err := root.Execute()
+			assert.NoError(t, err)
+			assert.Equal(t, tt.req, c.req)
+		})
+	}
+}
+
 func TestNewRootCmdFlags(t *testing.T) {
 	type flag struct {
 		shorthand   string
@@ -218,6 +316,29 @@ func TestNewRootCmdFlags(t *testing.T) {
 				err: false,
 			},
 		},
+		{
+			name: "reword_flag",
+			args: "--reword HEAD~1",
+			want: want{
+				flags: map[string]flag{
+					"reword": {
+						shorthand:   "",
+						value:       "HEAD~1",
+						defValue:    "",
+						changed:     true,
+						noOptDefVal: "",
+					},
+				},
+				err: false,
+			},
+		},
+		{
+			name: "reword_amend_flag",
+			args: "--reword HEAD~1 --amend",
+			want: want{
+				err: true,
+			},
+		},
 		{
 			name: "dry-run_flag",
 			args: "--dry-run",
@@ -20,6 +20,19 @@ func NewVersionCmd() *cobra.Command {
 		Short:       "Print the version information",
 		Annotations: annotations(),
 		Run: func(cmd *cobra.Command, args []string) {
+			if isJSON(cmd) {
+				res := Result{
+					Action:  actionVersion,
+					Version: cmd.Annotations,
+				}
+
+				if err := writeResult(cmd.OutOrStdout(), res, nil); err != nil {
+					log.Fatal("Unable to show version.")
+				}
+
+				return
+			}
+
 			tmpl := template.Must(template.New("version").Parse(verTmpl))
 			if err := tmpl.Execute(cmd.OutOrStdout(), cmd); err != nil {
 				log.Fatal("Unable to show version.")
@@ -0,0 +1,130 @@
+package commit
+
+import (
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/glob"
+	"github.com/mikelorant/committed/internal/repository"
+)
+
+// MatchAuthorRule returns the first rule matching a remote URL or the
+// worktree root. Rules without remotes or paths never match.
+func MatchAuthorRule(rules []config.AuthorRule, urls []string, root string) *config.AuthorRule {
+	for i, r := range rules {
+		for _, u := range urls {
+			if matchAny(r.Remotes, RemoteLocation(u)) {
+				return &rules[i]
+			}
+		}
+
+		if root == "" {
+			continue
+		}
+
+		for _, p := range r.Paths {
+			if glob.Match(expandHome(p), filepath.ToSlash(root)) {
+				return &rules[i]
+			}
+		}
+	}
+
+	return nil
+}
+
+// RemoteLocation returns the host and path of a remote URL without the
+// scheme, user, port or ".git" suffix. Both URLs and the scp-like syntax of
+// Git are supported.
+func RemoteLocation(str string) string {
+	var host, p string
+
+	switch u, err := url.Parse(str); {
+	case err == nil && u.Host != "":
+		host, p = u.Hostname(), u.Path
+	case strings.Contains(str, ":") && !strings.Contains(str, "://"):
+		host, p, _ = strings.Cut(str, ":")
+		if _, h, ok := strings.Cut(host, "@"); ok {
+			host = h
+		}
+	default:
+		p = str
+	}
+
+	p = strings.TrimSuffix(strings.Trim(p, "/"), ".git")
+
+	if host == "" {
+		return p
+	}
+
+	return strings.ToLower(host) + "/" + p
+}
+
+// Authors returns the users of the repository and the configured authors
+// with the default author first. The author of the matching rule takes
+// precedence over authors marked as default and is completed from the first
+// matching user when the rule only sets the email.
+func Authors(state *State) []repository.User {
+	authors := SortUsersByDefault(concatSlice(state.Repository.Users, state.Config.Authors)...)
+
+	r := state.AuthorRule
+	if r == nil {
+		return authors
+	}
+
+	author := r.Author
+	found := false
+
+	var us []repository.User
+
+	for _, a := range authors {
+		switch {
+		case !MatchesRule(a, r):
+			us = append(us, a)
+		case !found:
+			author, found = a, true
+		}
+	}
+
+	return append([]repository.User{author}, us...)
+}
+
+// MatchesRule reports if the user is the author of the rule. Emails are
+// compared without case and the name is only compared when the rule sets it.
+func MatchesRule(u repository.User, r *config.AuthorRule) bool {
+	if r == nil {
+		return true
+	}
+
+	if !strings.EqualFold(u.Email, r.Author.Email) {
+		return false
+	}
+
+	return r.Author.Name == "" || u.Name == r.Author.Name
+}
+
+func matchAny(patterns []string, str string) bool {
+	for _, p := range patterns {
+		if glob.Match(strings.ToLower(p), strings.ToLower(str)) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func expandHome(p string) string {
+	rest, ok := strings.CutPrefix(p, "~/")
+	if !ok {
+		return p
+	}
+
+	home, err := os.UserHomeDir()
+	if err != nil {
+		return p
+	}
+
+	return filepath.ToSlash(filepath.Join(home, rest))
+}
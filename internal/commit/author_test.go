@@ -0,0 +1,234 @@
+package commit_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRemoteLocation(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		url  string
+		want string
+	}{
+		{name: "scp", url: "git@github.com:acme/widget.git", want: "github.com/acme/widget"},
+		{name: "scp_no_user", url: "github.com:acme/widget", want: "github.com/acme/widget"},
+		{name: "https", url: "https://github.com/acme/widget.git", want: "github.com/acme/widget"},
+		{name: "https_user", url: "https://john@GitHub.com/acme/widget", want: "github.com/acme/widget"},
+		{name: "ssh_port", url: "ssh://git@gitlab.example.com:2222/acme/tools/widget.git", want: "gitlab.example.com/acme/tools/widget"},
+		{name: "trailing_slash", url: "https://github.com/acme/widget/", want: "github.com/acme/widget"},
+		{name: "path", url: "/srv/git/widget.git", want: "srv/git/widget"},
+		{name: "empty", url: "", want: ""},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, commit.RemoteLocation(tt.url))
+		})
+	}
+}
+
+func TestMatchAuthorRule(t *testing.T) {
+	t.Parallel()
+
+	rules := []config.AuthorRule{
+		{Author: repository.User{Email: "none@example.com"}},
+		{Author: repository.User{Email: "john.doe@acme.com"}, Remotes: []string{"github.com/acme/*"}},
+		{Author: repository.User{Email: "john.doe@example.com"}, Remotes: []string{"GitHub.com/john/*"}},
+		{Author: repository.User{Email: "john.doe@work.com"}, Paths: []string{"/home/john/work/**"}},
+	}
+
+	type args struct {
+		urls []string
+		root string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want *config.AuthorRule
+	}{
+		{
+			name: "remote",
+			args: args{urls: []string{"git@github.com:acme/widget.git"}},
+			want: &rules[1],
+		},
+		{
+			name: "remote_case",
+			args: args{urls: []string{"https://github.com/John/widget"}},
+			want: &rules[2],
+		},
+		{
+			name: "remote_second",
+			args: args{urls: []string{"git@gitlab.com:acme/widget.git", "git@github.com:acme/widget.git"}},
+			want: &rules[1],
+		},
+		{
+			name: "remote_nested",
+			args: args{urls: []string{"git@github.com:acme/widget/tools.git"}},
+		},
+		{
+			name: "path",
+			args: args{root: "/home/john/work/acme/widget"},
+			want: &rules[3],
+		},
+		{
+			name: "remote_before_path",
+			args: args{urls: []string{"git@github.com:acme/widget.git"}, root: "/home/john/work/widget"},
+			want: &rules[1],
+		},
+		{
+			name: "none",
+			args: args{urls: []string{"git@gitlab.com:acme/widget.git"}, root: "/home/john/personal/widget"},
+		},
+		{
+			name: "empty",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := commit.MatchAuthorRule(rules, tt.args.urls, tt.args.root)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestAuthors(t *testing.T) {
+	t.Parallel()
+
+	repoUser := repository.User{Name: "John Doe", Email: "john.doe@example.com"}
+	workUser := repository.User{Name: "John Doe", Email: "john.doe@acme.com"}
+	defaultUser := repository.User{Name: "John Doe", Email: "jdoe@example.org", Default: true}
+
+	type args struct {
+		users   []repository.User
+		authors []repository.User
+		rule    *config.AuthorRule
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want []repository.User
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "default",
+			args: args{
+				users:   []repository.User{repoUser},
+				authors: []repository.User{workUser, defaultUser},
+			},
+			want: []repository.User{defaultUser, repoUser, workUser},
+		},
+		{
+			name: "rule",
+			args: args{
+				users:   []repository.User{repoUser},
+				authors: []repository.User{workUser, defaultUser},
+				rule:    &config.AuthorRule{Author: repository.User{Email: "John.Doe@acme.com"}},
+			},
+			want: []repository.User{workUser, defaultUser, repoUser},
+		},
+		{
+			name: "rule_not_found",
+			args: args{
+				users: []repository.User{repoUser},
+				rule:  &config.AuthorRule{Author: workUser},
+			},
+			want: []repository.User{workUser, repoUser},
+		},
+		{
+			name: "rule_name",
+			args: args{
+				users: []repository.User{{Name: "J Doe", Email: "john.doe@acme.com"}},
+				rule:  &config.AuthorRule{Author: workUser},
+			},
+			want: []repository.User{workUser, {Name: "J Doe", Email: "john.doe@acme.com"}},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := commit.State{
+				Repository: repository.Description{Users: tt.args.users},
+				Config:     config.Config{Authors: tt.args.authors},
+				AuthorRule: tt.args.rule,
+			}
+
+			assert.Equal(t, tt.want, commit.Authors(&state))
+		})
+	}
+}
+
+func TestMatchesRule(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		user repository.User
+		rule *config.AuthorRule
+		want bool
+	}{
+		{
+			name: "no_rule",
+			user: repository.User{Name: "John Doe", Email: "john.doe@example.com"},
+			want: true,
+		},
+		{
+			name: "match",
+			user: repository.User{Name: "John Doe", Email: "john.doe@acme.com"},
+			rule: &config.AuthorRule{Author: repository.User{Name: "John Doe", Email: "john.doe@acme.com"}},
+			want: true,
+		},
+		{
+			name: "email_only",
+			user: repository.User{Name: "J Doe", Email: "JOHN.DOE@acme.com"},
+			rule: &config.AuthorRule{Author: repository.User{Email: "john.doe@acme.com"}},
+			want: true,
+		},
+		{
+			name: "email_mismatch",
+			user: repository.User{Name: "John Doe", Email: "john.doe@example.com"},
+			rule: &config.AuthorRule{Author: repository.User{Email: "john.doe@acme.com"}},
+			want: false,
+		},
+		{
+			name: "name_mismatch",
+			user: repository.User{Name: "J Doe", Email: "john.doe@acme.com"},
+			rule: &config.AuthorRule{Author: repository.User{Name: "John Doe", Email: "john.doe@acme.com"}},
+			want: false,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, commit.MatchesRule(tt.user, tt.rule))
+		})
+	}
+}
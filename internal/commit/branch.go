@@ -0,0 +1,63 @@
+package commit
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/mikelorant/committed/internal/glob"
+)
+
+// Maximum length of a branch name suggested from the summary.
+const branchNameLength = 50
+
+// BranchName suggests a branch name from the summary. Letters and digits are
+// kept in lower case and every other run of characters becomes a hyphen. The
+// name is shortened at a hyphen when longer than 50 characters.
+func BranchName(summary string) string {
+	var sb strings.Builder
+
+	hyphen := false
+
+	for _, r := range strings.ToLower(summary) {
+		switch {
+		case r < unicode.MaxASCII && (unicode.IsLetter(r) || unicode.IsDigit(r)):
+			if hyphen && sb.Len() > 0 {
+				sb.WriteRune('-')
+			}
+
+			sb.WriteRune(r)
+
+			hyphen = false
+		default:
+			hyphen = true
+		}
+	}
+
+	name := sb.String()
+	if len(name) <= branchNameLength {
+		return name
+	}
+
+	name = name[:branchNameLength]
+	if i := strings.LastIndex(name, "-"); i > 0 {
+		name = name[:i]
+	}
+
+	return name
+}
+
+// Protected reports if the branch matches any of the protected branch
+// patterns.
+func Protected(patterns []string, branch string) bool {
+	if branch == "" {
+		return false
+	}
+
+	for _, p := range patterns {
+		if glob.Match(p, branch) {
+			return true
+		}
+	}
+
+	return false
+}
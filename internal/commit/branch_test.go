@@ -0,0 +1,75 @@
+package commit_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBranchName(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		summary string
+		want    string
+	}{
+		{name: "empty", summary: "", want: ""},
+		{name: "words", summary: "Fix crash on empty config", want: "fix-crash-on-empty-config"},
+		{name: "punctuation", summary: "Add `--date` flag (author date)", want: "add-date-flag-author-date"},
+		{name: "digits", summary: "Bump Go to 1.24", want: "bump-go-to-1-24"},
+		{name: "non_ascii", summary: "Café naïve résumé", want: "caf-na-ve-r-sum"},
+		{name: "emoji", summary: "🐛 Fix crash", want: "fix-crash"},
+		{
+			name:    "long",
+			summary: "Replace the configuration loader with a streaming decoder for large files",
+			want:    "replace-the-configuration-loader-with-a-streaming",
+		},
+		{
+			name:    "long_word",
+			summary: "Aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+			want:    "aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, commit.BranchName(tt.summary))
+		})
+	}
+}
+
+func TestProtected(t *testing.T) {
+	t.Parallel()
+
+	patterns := []string{"main", "release/*"}
+
+	tests := []struct {
+		name   string
+		branch string
+		want   bool
+	}{
+		{name: "exact", branch: "main", want: true},
+		{name: "glob", branch: "release/1.0", want: true},
+		{name: "glob_nested", branch: "release/1.0/fix", want: false},
+		{name: "other", branch: "feature", want: false},
+		{name: "prefix", branch: "maintenance", want: false},
+		{name: "empty", branch: "", want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, commit.Protected(patterns, tt.branch))
+		})
+	}
+}
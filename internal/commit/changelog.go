@@ -0,0 +1,144 @@
+package commit
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/repository"
+)
+
+// Changelog is the commits of a revision range grouped into sections by the
+// emoji of the subject.
+type Changelog struct {
+	Sections []ChangelogSection
+}
+
+// ChangelogSection is a heading with the commits grouped under it, newest
+// first.
+type ChangelogSection struct {
+	Heading string
+	Entries []ChangelogEntry
+}
+
+// ChangelogEntry is a commit of the changelog with the summary of the subject.
+type ChangelogEntry struct {
+	Hash    string
+	Summary string
+}
+
+// ChangelogRequest creates the changelog of the revision range.
+type ChangelogRequest struct {
+	Revision string
+	Emojis   *emoji.Set
+	Config   config.Changelog
+}
+
+// changelogOther is the heading of commits without an emoji in the set.
+const changelogOther = "Other"
+
+// NewChangelogRequest creates a request with the sections of the config.
+func NewChangelogRequest(state *State, rev string) *ChangelogRequest {
+	return &ChangelogRequest{
+		Revision: rev,
+		Emojis:   state.Emojis,
+		Config:   state.Config.Changelog,
+	}
+}
+
+// Changelog returns the changelog of the revision range.
+func (c *Commit) Changelog(req *ChangelogRequest) (Changelog, error) {
+	hs, err := c.Repoer.Log(req.Revision)
+	if err != nil {
+		return Changelog{}, fmt.Errorf("unable to get commits: %w", err)
+	}
+
+	return NewChangelog(req.Emojis, req.Config, hs)
+}
+
+// NewChangelog groups the commits by the emoji of the subject. The sections of
+// the config come first in order, followed by a section for every other emoji
+// in the order of the set headed by its description. Commits without an emoji
+// in the set are last. Sections without commits are left out.
+func NewChangelog(set *emoji.Set, cfg config.Changelog, hs []repository.Head) (Changelog, error) {
+	var sections []ChangelogSection
+
+	// Emojis are keyed by shortcode so both forms match the same section.
+	index := make(map[string]int)
+
+	for _, s := range cfg.Sections {
+		for _, str := range s.Emojis {
+			e := set.Find(str)
+			if !e.Valid {
+				return Changelog{}, fmt.Errorf("%w: changelog emoji not found: %v", ErrConfig, str)
+			}
+
+			index[e.Emoji.Shortcode] = len(sections)
+		}
+
+		sections = append(sections, ChangelogSection{Heading: s.Heading})
+	}
+
+	for _, e := range set.Emojis {
+		if _, ok := index[e.Shortcode]; ok {
+			continue
+		}
+
+		index[e.Shortcode] = len(sections)
+		sections = append(sections, ChangelogSection{Heading: emojiHeading(e)})
+	}
+
+	other := len(sections)
+	sections = append(sections, ChangelogSection{Heading: changelogOther})
+
+	for _, h := range hs {
+		i := other
+		summary := MessageToSubject(h.Message)
+
+		if e := MessageToEmoji(set, summary); e.Valid {
+			i = index[e.Emoji.Shortcode]
+			_, summary, _ = strings.Cut(summary, " ")
+		}
+
+		sections[i].Entries = append(sections[i].Entries, ChangelogEntry{
+			Hash:    h.Hash,
+			Summary: summary,
+		})
+	}
+
+	var cl Changelog
+
+	for _, s := range sections {
+		if len(s.Entries) > 0 {
+			cl.Sections = append(cl.Sections, s)
+		}
+	}
+
+	return cl, nil
+}
+
+// Markdown renders the changelog with a heading for each section and a list
+// item for each commit.
+func (cl Changelog) Markdown() string {
+	var sb strings.Builder
+
+	for i, s := range cl.Sections {
+		if i > 0 {
+			sb.WriteString("\n")
+		}
+
+		fmt.Fprintf(&sb, "### %s\n\n", s.Heading)
+
+		for _, e := range s.Entries {
+			fmt.Fprintf(&sb, "- %s (%.7s)\n", e.Summary, e.Hash)
+		}
+	}
+
+	return sb.String()
+}
+
+// emojiHeading returns the emoji with the description as the heading.
+func emojiHeading(e emoji.Emoji) string {
+	return fmt.Sprintf("%s %s", e.Character, strings.TrimSuffix(e.Description, "."))
+}
@@ -0,0 +1,229 @@
+package commit_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func testChangelogLog() []repository.Head {
+	return []repository.Head{
+		{Hash: "5", Message: "Merge branch 'feature'\n"},
+		{Hash: "4", Message: "🐛 Fix the parser\n\nBody\n"},
+		{Hash: "3", Message: ":sparkles: Add the\nparser\n"},
+		{Hash: "2", Message: ":bug: Fix the loader\n"},
+		{Hash: "1", Message: ":tada: Initial commit\n"},
+	}
+}
+
+func TestNewChangelog(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		changelog commit.Changelog
+		err       string
+	}
+
+	tests := []struct {
+		name string
+		cfg  config.Changelog
+		log  []repository.Head
+		want want
+	}{
+		{
+			name: "default",
+			log:  testChangelogLog(),
+			want: want{
+				changelog: commit.Changelog{Sections: []commit.ChangelogSection{
+					{
+						Heading: "🐛 Fix a bug",
+						Entries: []commit.ChangelogEntry{
+							{Hash: "4", Summary: "Fix the parser"},
+							{Hash: "2", Summary: "Fix the loader"},
+						},
+					},
+					{
+						Heading: "✨ Introduce new features",
+						Entries: []commit.ChangelogEntry{
+							{Hash: "3", Summary: "Add the parser"},
+						},
+					},
+					{
+						Heading: "🎉 Begin a project",
+						Entries: []commit.ChangelogEntry{
+							{Hash: "1", Summary: "Initial commit"},
+						},
+					},
+					{
+						Heading: "Other",
+						Entries: []commit.ChangelogEntry{
+							{Hash: "5", Summary: "Merge branch 'feature'"},
+						},
+					},
+				}},
+			},
+		},
+		{
+			name: "config",
+			cfg: config.Changelog{Sections: []config.ChangelogSection{
+				{Heading: "Features", Emojis: []string{":sparkles:", "🎉"}},
+				{Heading: "Fixes", Emojis: []string{":bug:"}},
+				{Heading: "Performance", Emojis: []string{":zap:"}},
+			}},
+			log: testChangelogLog(),
+			want: want{
+				changelog: commit.Changelog{Sections: []commit.ChangelogSection{
+					{
+						Heading: "Features",
+						Entries: []commit.ChangelogEntry{
+							{Hash: "3", Summary: "Add the parser"},
+							{Hash: "1", Summary: "Initial commit"},
+						},
+					},
+					{
+						Heading: "Fixes",
+						Entries: []commit.ChangelogEntry{
+							{Hash: "4", Summary: "Fix the parser"},
+							{Hash: "2", Summary: "Fix the loader"},
+						},
+					},
+					{
+						Heading: "Other",
+						Entries: []commit.ChangelogEntry{
+							{Hash: "5", Summary: "Merge branch 'feature'"},
+						},
+					},
+				}},
+			},
+		},
+		{
+			name: "empty",
+		},
+		{
+			name: "invalid_emoji",
+			cfg: config.Changelog{Sections: []config.ChangelogSection{
+				{Heading: "Fixes", Emojis: []string{":invalid:"}},
+			}},
+			log: testChangelogLog(),
+			want: want{
+				err: "invalid config: changelog emoji not found: :invalid:",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			cl, err := commit.NewChangelog(emoji.New(), tt.cfg, tt.log)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.changelog, cl)
+		})
+	}
+}
+
+func TestChangelogMarkdown(t *testing.T) {
+	t.Parallel()
+
+	cl := commit.Changelog{Sections: []commit.ChangelogSection{
+		{
+			Heading: "Fixes",
+			Entries: []commit.ChangelogEntry{
+				{Hash: "918c48b83bd081e863dbe1b80f8998f058cd8294", Summary: "Fix the parser"},
+				{Hash: "af2d6a6954d532f8ffb47615169c8fdf9d383a1a", Summary: "Fix the loader"},
+			},
+		},
+		{
+			Heading: "Other",
+			Entries: []commit.ChangelogEntry{
+				{Hash: "6ecf0ef2c2dffb796033e5a02219af86ec6584e5", Summary: "Update the readme"},
+			},
+		},
+	}}
+
+	want := "### Fixes\n\n" +
+		"- Fix the parser (918c48b)\n" +
+		"- Fix the loader (af2d6a6)\n" +
+		"\n" +
+		"### Other\n\n" +
+		"- Update the readme (6ecf0ef)\n"
+
+	assert.Equal(t, want, cl.Markdown())
+	assert.Equal(t, "", commit.Changelog{}.Markdown())
+}
+
+func TestChangelog(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		logErr error
+		want   commit.Changelog
+		err    string
+	}{
+		{
+			name: "changelog",
+			want: commit.Changelog{Sections: []commit.ChangelogSection{
+				{
+					Heading: "Fixes",
+					Entries: []commit.ChangelogEntry{
+						{Hash: "4", Summary: "Fix the parser"},
+						{Hash: "2", Summary: "Fix the loader"},
+					},
+				},
+			}},
+		},
+		{
+			name:   "log_error",
+			logErr: errMock,
+			err:    "unable to get commits: error",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := MockRepository{
+				log: []repository.Head{
+					{Hash: "4", Message: "🐛 Fix the parser\n"},
+					{Hash: "2", Message: ":bug: Fix the loader\n"},
+				},
+				logErr: tt.logErr,
+			}
+
+			c := commit.Commit{
+				Repoer: &repo,
+			}
+
+			req := commit.ChangelogRequest{
+				Revision: "HEAD",
+				Emojis:   emoji.New(),
+				Config: config.Changelog{Sections: []config.ChangelogSection{
+					{Heading: "Fixes", Emojis: []string{":bug:"}},
+				}},
+			}
+
+			cl, err := c.Changelog(&req)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, cl)
+		})
+	}
+}
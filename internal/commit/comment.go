@@ -0,0 +1,197 @@
+package commit
+
+import (
+	"strings"
+
+	"github.com/mikelorant/committed/internal/repository"
+)
+
+// Cleanup is how Git cleans up the message as set by commit.cleanup.
+type Cleanup int
+
+const (
+	CleanupDefault Cleanup = iota
+	CleanupStrip
+	CleanupWhitespace
+	CleanupVerbatim
+	CleanupScissors
+)
+
+// scissors marks the start of the diff added by git commit --verbose. Git
+// ignores the line and everything below it.
+const scissors = "------------------------ >8 ------------------------"
+
+// autoCommentChars are the characters Git chooses from when the comment char
+// is auto.
+const autoCommentChars = "#;@!$%^&|:"
+
+func ParseCleanup(str string) Cleanup {
+	cleanup := map[string]Cleanup{
+		"":           CleanupDefault,
+		"default":    CleanupDefault,
+		"strip":      CleanupStrip,
+		"whitespace": CleanupWhitespace,
+		"verbatim":   CleanupVerbatim,
+		"scissors":   CleanupScissors,
+	}
+
+	return cleanup[strings.ToLower(str)]
+}
+
+// Resolve returns the mode of the default cleanup. Git strips comments from
+// messages that were edited and only whitespace otherwise.
+func (c Cleanup) Resolve(edited bool) Cleanup {
+	switch {
+	case c != CleanupDefault:
+		return c
+	case edited:
+		return CleanupStrip
+	default:
+		return CleanupWhitespace
+	}
+}
+
+// CommentChar resolves the comment char of the config for the message. Auto
+// chooses the first character that starts no line of the message, as Git
+// does.
+func CommentChar(cfg, msg string) string {
+	switch cfg {
+	case "":
+		return repository.DefaultCommentChar
+	case "auto":
+	default:
+		return cfg
+	}
+
+	ls := strings.Split(msg, "\n")
+
+	for _, c := range autoCommentChars {
+		if !startsAny(ls, string(c)) {
+			return string(c)
+		}
+	}
+
+	return repository.DefaultCommentChar
+}
+
+// fileCommentChar resolves the comment char of a message file. Git has
+// already added comment lines so auto chooses the character that starts them.
+func fileCommentChar(cfg, msg string) string {
+	if cfg != "auto" {
+		return CommentChar(cfg, msg)
+	}
+
+	ls := strings.Split(msg, "\n")
+
+	for _, c := range autoCommentChars {
+		if isCommentChar(ls, string(c)) {
+			return string(c)
+		}
+	}
+
+	return CommentChar(cfg, msg)
+}
+
+// SplitComments separates the message of a message file from its comment
+// block. The comment block keeps the comment lines removed by the cleanup
+// and everything from the scissors line onwards so it can be written back
+// unchanged.
+func SplitComments(msg, char string, mode Cleanup) (string, string) {
+	if char == "" {
+		char = repository.DefaultCommentChar
+	}
+
+	strip := mode.Resolve(true) == CleanupStrip
+
+	var message, comments []string
+
+	ls := strings.Split(msg, "\n")
+
+	for i, l := range ls {
+		if l == char+" "+scissors {
+			comments = append(comments, ls[i:]...)
+
+			break
+		}
+
+		if strip && strings.HasPrefix(l, char) {
+			comments = append(comments, l)
+
+			continue
+		}
+
+		message = append(message, l)
+	}
+
+	return CleanupMessage(strings.Join(message, "\n"), char, mode),
+		strings.TrimRight(strings.Join(comments, "\n"), "\n")
+}
+
+// CleanupMessage cleans up the message the same way as Git. Trailing
+// whitespace and surrounding empty lines are removed and consecutive empty
+// lines are collapsed. Strip also removes comment lines while scissors
+// removes everything from the scissors line onwards. The default mode strips
+// the message.
+func CleanupMessage(msg, char string, mode Cleanup) string {
+	mode = mode.Resolve(true)
+
+	if mode == CleanupVerbatim {
+		return msg
+	}
+
+	if char == "" {
+		char = repository.DefaultCommentChar
+	}
+
+	var ls []string
+
+	for _, l := range strings.Split(msg, "\n") {
+		if mode == CleanupScissors && l == char+" "+scissors {
+			break
+		}
+
+		if mode == CleanupStrip && strings.HasPrefix(l, char) {
+			continue
+		}
+
+		l = strings.TrimRight(l, " \t\r")
+
+		if l == "" && (len(ls) == 0 || ls[len(ls)-1] == "") {
+			continue
+		}
+
+		ls = append(ls, l)
+	}
+
+	return strings.TrimRight(strings.Join(ls, "\n"), "\n")
+}
+
+// isCommentChar reports whether the lines starting with the character are
+// all comment lines written by Git.
+func isCommentChar(ls []string, c string) bool {
+	var found bool
+
+	for _, l := range ls {
+		if !strings.HasPrefix(l, c) {
+			continue
+		}
+
+		if l != c && !strings.HasPrefix(l, c+" ") && !strings.HasPrefix(l, c+"\t") {
+			return false
+		}
+
+		found = true
+	}
+
+	return found
+}
+
+func startsAny(ls []string, c string) bool {
+	for _, l := range ls {
+		if strings.HasPrefix(l, c) {
+			return true
+		}
+	}
+
+	return false
+}
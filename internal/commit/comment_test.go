@@ -0,0 +1,236 @@
+package commit_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSplitComments(t *testing.T) {
+	t.Parallel()
+
+	files, err := filepath.Glob(filepath.Join("testdata", "*.input"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range files {
+		file := file
+
+		_, filename := filepath.Split(file)
+		ext := filepath.Ext(file)
+		testLen := len(filename) - len(ext)
+		testName := filename[:testLen]
+
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			source, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal("unable to read source file:", err)
+			}
+
+			msg, comments := commit.SplitComments(string(source), "#", commit.CleanupDefault)
+
+			autogold.ExpectFile(t, autogold.Raw(msg+"\n---\n"+comments), autogold.Name(testName))
+		})
+	}
+}
+
+func TestSplitCommentsChar(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		char     string
+		mode     commit.Cleanup
+		msg      string
+		message  string
+		comments string
+	}{
+		{
+			name:     "default",
+			msg:      "summary\n# comment",
+			message:  "summary",
+			comments: "# comment",
+		},
+		{
+			name:     "semicolon",
+			char:     ";",
+			msg:      "summary\n\n# body\n; comment",
+			message:  "summary\n\n# body",
+			comments: "; comment",
+		},
+		{
+			name:     "semicolon_scissors",
+			char:     ";",
+			msg:      "summary\n; ------------------------ >8 ------------------------\ndiff",
+			message:  "summary",
+			comments: "; ------------------------ >8 ------------------------\ndiff",
+		},
+		{
+			name:    "scissors_other_char",
+			char:    ";",
+			msg:     "summary\n# ------------------------ >8 ------------------------",
+			message: "summary\n# ------------------------ >8 ------------------------",
+		},
+		{
+			name:     "whitespace",
+			mode:     commit.CleanupWhitespace,
+			msg:      "summary  \n\n\n# body\n# ------------------------ >8 ------------------------\ndiff",
+			message:  "summary\n\n# body",
+			comments: "# ------------------------ >8 ------------------------\ndiff",
+		},
+		{
+			name:     "scissors",
+			mode:     commit.CleanupScissors,
+			msg:      "summary\n# body\n# ------------------------ >8 ------------------------\ndiff",
+			message:  "summary\n# body",
+			comments: "# ------------------------ >8 ------------------------\ndiff",
+		},
+		{
+			name:    "verbatim",
+			mode:    commit.CleanupVerbatim,
+			msg:     "summary  \n\n\n# body\n",
+			message: "summary  \n\n\n# body\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			message, comments := commit.SplitComments(tt.msg, tt.char, tt.mode)
+			assert.Equal(t, tt.message, message)
+			assert.Equal(t, tt.comments, comments)
+		})
+	}
+}
+
+func TestCleanupMessage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		char string
+		mode commit.Cleanup
+		msg  string
+		want string
+	}{
+		{
+			name: "default",
+			msg:  "\nbody  \n# comment\n\n\nbody\n\n",
+			want: "body\n\nbody",
+		},
+		{
+			name: "strip",
+			mode: commit.CleanupStrip,
+			msg:  "body\n# comment\nbody",
+			want: "body\nbody",
+		},
+		{
+			name: "strip_char",
+			char: ";",
+			mode: commit.CleanupStrip,
+			msg:  "body\n# heading\n; comment",
+			want: "body\n# heading",
+		},
+		{
+			name: "whitespace",
+			mode: commit.CleanupWhitespace,
+			msg:  "body\t\n\n\n# heading\n",
+			want: "body\n\n# heading",
+		},
+		{
+			name: "scissors",
+			mode: commit.CleanupScissors,
+			msg:  "body\n# heading\n# ------------------------ >8 ------------------------\ndiff",
+			want: "body\n# heading",
+		},
+		{
+			name: "verbatim",
+			mode: commit.CleanupVerbatim,
+			msg:  "\nbody  \n# comment\n\n",
+			want: "\nbody  \n# comment\n\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, commit.CleanupMessage(tt.msg, tt.char, tt.mode))
+		})
+	}
+}
+
+func TestCommentChar(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		cfg  string
+		msg  string
+		want string
+	}{
+		{name: "empty", want: "#"},
+		{name: "char", cfg: ";", want: ";"},
+		{name: "auto", cfg: "auto", msg: "summary", want: "#"},
+		{name: "auto_used", cfg: "auto", msg: "summary\n\n#123 issue", want: ";"},
+		{name: "auto_used_multiple", cfg: "auto", msg: "# heading\n; note", want: "@"},
+		{name: "auto_all_used", cfg: "auto", msg: "#\n;\n@\n!\n$\n%\n^\n&\n|\n:", want: "#"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, commit.CommentChar(tt.cfg, tt.msg))
+		})
+	}
+}
+
+func TestParseCleanup(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		str      string
+		cleanup  commit.Cleanup
+		edited   commit.Cleanup
+		unedited commit.Cleanup
+	}{
+		{name: "empty", str: "", cleanup: commit.CleanupDefault, edited: commit.CleanupStrip, unedited: commit.CleanupWhitespace},
+		{name: "default", str: "default", cleanup: commit.CleanupDefault, edited: commit.CleanupStrip, unedited: commit.CleanupWhitespace},
+		{name: "strip", str: "strip", cleanup: commit.CleanupStrip, edited: commit.CleanupStrip, unedited: commit.CleanupStrip},
+		{name: "whitespace", str: "whitespace", cleanup: commit.CleanupWhitespace, edited: commit.CleanupWhitespace, unedited: commit.CleanupWhitespace},
+		{name: "verbatim", str: "verbatim", cleanup: commit.CleanupVerbatim, edited: commit.CleanupVerbatim, unedited: commit.CleanupVerbatim},
+		{name: "scissors", str: "scissors", cleanup: commit.CleanupScissors, edited: commit.CleanupScissors, unedited: commit.CleanupScissors},
+		{name: "uppercase", str: "STRIP", cleanup: commit.CleanupStrip, edited: commit.CleanupStrip, unedited: commit.CleanupStrip},
+		{name: "invalid", str: "invalid", cleanup: commit.CleanupDefault, edited: commit.CleanupStrip, unedited: commit.CleanupWhitespace},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := commit.ParseCleanup(tt.str)
+			assert.Equal(t, tt.cleanup, c)
+			assert.Equal(t, tt.edited, c.Resolve(true))
+			assert.Equal(t, tt.unedited, c.Resolve(false))
+		})
+	}
+}
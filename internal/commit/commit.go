@@ -7,12 +7,17 @@ import (
 	"io"
 	"os"
 	"os/exec"
+	"path/filepath"
 	"strings"
+	"time"
 
 	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/generator"
+	"github.com/mikelorant/committed/internal/keymap"
 	"github.com/mikelorant/committed/internal/repository"
 	"github.com/mikelorant/committed/internal/snapshot"
+	"github.com/mikelorant/committed/internal/spell"
 )
 
 type Commit struct {
@@ -26,6 +31,9 @@ type Commit struct {
 	Creator     Creator
 	Saver       Saver
 	Remover     Remover
+
+	commentChar string
+	cleanup     Cleanup
 }
 
 type (
@@ -42,6 +50,14 @@ type Repoer interface {
 	Open() error
 	Describe() (repository.Description, error)
 	Apply(repository.Commit) error
+	Head() (repository.Head, error)
+	Lookup(string) (repository.Head, error)
+	Log(string) ([]repository.Head, error)
+	Unpushed() ([]repository.Head, error)
+	CreateBranch(string, io.Writer) error
+	RevertBranch(string, io.Writer) error
+	CheckReword(string) error
+	Autosquash(string, io.Writer) error
 	IgnoreGlobalConfig()
 }
 
@@ -56,12 +72,15 @@ type Snapshotter interface {
 }
 
 type Options struct {
-	ConfigFile   string
-	SnapshotFile string
-	DryRun       bool
-	Amend        bool
-	Mode         Mode
-	File         FileOptions
+	ConfigFile     string
+	SnapshotFile   string
+	DictionaryFile string
+	DryRun         bool
+	Amend          bool
+	Reword         string
+	Mode           Mode
+	File           FileOptions
+	Identity       IdentityOptions
 }
 
 type FileOptions struct {
@@ -71,22 +90,46 @@ type FileOptions struct {
 }
 
 type Request struct {
-	Apply       bool
-	Emoji       string
-	Summary     string
-	Body        string
-	RawBody     string
-	Footer      string
-	Author      repository.User
-	Amend       bool
-	DryRun      bool
-	File        bool
-	MessageFile string
-	Config      config.Config
+	Apply         bool
+	Emoji         string
+	Summary       string
+	Body          string
+	RawBody       string
+	Footer        string
+	Author        repository.User
+	AuthorDate    time.Time
+	Committer     repository.User
+	CommitterDate time.Time
+	Amend         bool
+	Branch        string
+	Fixup         Fixup
+	Target        repository.Head
+	Reword        bool
+	DryRun        bool
+	File          bool
+	MessageFile   string
+	Comments      string
+	Config        config.Config
+	Headless      bool
+	Output        io.Writer
 }
 
 type Mode int
 
+var (
+	ErrConfig   = errors.New("invalid config")
+	ErrCommit   = errors.New("commit failed")
+	ErrReword   = errors.New("invalid reword")
+	ErrDirty    = errors.New("worktree has changes")
+	ErrUpstream = errors.New("branch has no upstream")
+)
+
+// Files of the repository relative to the root of the worktree.
+const (
+	repositoryDictionary = ".committed/dictionary.txt"
+	repositoryEmojiRules = ".committed/emoji.yaml"
+)
+
 const (
 	ModeUnset Mode = iota
 	ModeCommit
@@ -110,13 +153,18 @@ func New() Commit {
 func (c *Commit) Configure(opts Options) (*State, error) {
 	cfg, err := getConfig(c.Opener, c.Configer, opts.ConfigFile)
 	if err != nil {
-		return nil, fmt.Errorf("unable to get config: %w", err)
+		return nil, fmt.Errorf("%w: unable to get config: %w", ErrConfig, err)
 	}
 
 	if cfg.View.IgnoreGlobalAuthor {
 		c.Repoer.IgnoreGlobalConfig()
 	}
 
+	km, err := keymap.New(cfg.Keys)
+	if err != nil {
+		return nil, fmt.Errorf("%w: unable to get key map: %w", ErrConfig, err)
+	}
+
 	repo, err := getRepo(c.Repoer)
 	if err != nil {
 		return nil, fmt.Errorf("unable to get repository: %w", err)
@@ -127,28 +175,79 @@ func (c *Commit) Configure(opts Options) (*State, error) {
 		return nil, fmt.Errorf("unable to get snapshot: %w", err)
 	}
 
+	var spelling *spell.Checker
+	if cfg.View.SpellCheck {
+		spelling, err = getSpelling(c.Opener, opts.DictionaryFile, repoFile(repo, repositoryDictionary))
+		if err != nil {
+			return nil, fmt.Errorf("unable to get spelling: %w", err)
+		}
+	}
+
+	rules, err := getEmojiRules(c.Opener, repoFile(repo, repositoryEmojiRules))
+	if err != nil {
+		return nil, fmt.Errorf("unable to get emoji rules: %w", err)
+	}
+
+	// Git creates the commit of a message file so the overrides are only
+	// used when committing.
+	var identity Identity
+	if opts.Mode <= ModeCommit {
+		identity, err = NewIdentity(opts.Identity)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get identity: %w", err)
+		}
+	}
+
+	var reword repository.Head
+	if opts.Reword != "" {
+		reword, err = getReword(c.Repoer, repo, opts.Reword)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrReword, err)
+		}
+	}
+
 	var file File
 	if opts.Mode > ModeCommit {
-		file, err = readFile(c.ReadFiler, opts)
+		file, err = readFile(c.ReadFiler, opts, repo.CommentChar, ParseCleanup(repo.Cleanup))
 		if err != nil {
 			return nil, fmt.Errorf("unable to read message file: %w", err)
 		}
 	}
 
+	if file.Source == SourceCommit && !file.Amend {
+		file.Commit, err = c.Repoer.Lookup(opts.File.SHA)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get source commit: %w", err)
+		}
+
+		if !hasSummary(file.Message) {
+			file.Message = file.Commit.Message
+		}
+	}
+
 	if file.Amend {
 		opts.Amend = true
 	}
 
 	c.Options = opts
+	c.commentChar = repo.CommentChar
+	c.cleanup = ParseCleanup(repo.Cleanup)
 
 	return &State{
-		Placeholders: placeholders(),
+		Placeholders: placeholders(km),
 		Emojis:       getEmojis(c.Emojier, cfg),
 		Repository:   repo,
 		Config:       cfg,
+		KeyMap:       km,
 		Snapshot:     snap,
 		Options:      opts,
 		File:         file,
+		Identity:     identity,
+		Reword:       reword,
+		AuthorRule:   MatchAuthorRule(cfg.AuthorRules, repo.RemoteURLs, repo.Worktree.Root),
+		Spelling:     spelling,
+		Generators:   generator.New(cfg.Generators),
+		EmojiRules:   append(rules, cfg.EmojiRules...),
 	}, nil
 }
 
@@ -157,15 +256,28 @@ func (c *Commit) Apply(req *Request) error {
 		return nil
 	}
 
+	// Clean up the body as Git does so the commit matches the request.
+	cleanup := c.cleanup.Resolve(req.File)
+	body := CleanupMessage(req.Body, CommentChar(c.commentChar, req.Body), cleanup)
+
+	subject, body := FixupMessage(req.Fixup, req.Target.Message, req.Emoji, req.Summary, body)
+
 	com := repository.Commit{
-		Author:      UserToAuthor(req.Author),
-		Subject:     EmojiSummaryToSubject(req.Emoji, req.Summary),
-		Body:        req.Body,
-		Footer:      req.Footer,
-		Amend:       req.Amend,
-		DryRun:      req.DryRun,
-		File:        req.File,
-		MessageFile: req.MessageFile,
+		Author:        UserToAuthor(req.Author),
+		Date:          req.AuthorDate,
+		Committer:     req.Committer,
+		CommitterDate: req.CommitterDate,
+		Subject:       subject,
+		Body:          body,
+		Footer:        req.Footer,
+		Amend:         req.Amend,
+		AllowEmpty:    req.Reword,
+		NoHooks:       req.Reword,
+		DryRun:        req.DryRun,
+		File:          req.File,
+		MessageFile:   req.MessageFile,
+		Comments:      req.Comments,
+		Output:        req.Output,
 	}
 
 	snap := snapshot.Snapshot{
@@ -175,7 +287,7 @@ func (c *Commit) Apply(req *Request) error {
 		Footer:  req.Footer,
 		Author:  req.Author,
 		Amend:   req.Amend,
-		Restore: true,
+		Restore: !req.Reword,
 	}
 
 	if req.Config.Update {
@@ -192,18 +304,52 @@ func (c *Commit) Apply(req *Request) error {
 		return nil
 	}
 
+	// Headless requests leave the snapshot of the user interface unchanged.
+	if req.Headless {
+		if err := c.Repoer.Apply(com); err != nil {
+			return fmt.Errorf("%w: unable to apply commit: %w", ErrCommit, err)
+		}
+
+		return nil
+	}
+
+	// The branch is created first so the commit is made on it. Dry runs
+	// leave the current branch unchanged.
+	if req.Branch != "" && !req.DryRun {
+		if err := c.Repoer.CreateBranch(req.Branch, req.Output); err != nil {
+			if err := setSnapshot(c.Creator, c.Snapshotter, c.Options.SnapshotFile, snap); err != nil {
+				return fmt.Errorf("unable to set snapshot: %w", err)
+			}
+
+			return fmt.Errorf("%w: unable to create branch: %w", ErrCommit, err)
+		}
+	}
+
 	switch err := c.Repoer.Apply(com); {
 	case err != nil:
+		err = c.revertBranch(req, err)
+
 		var exitErr *exec.ExitError
 
 		if !errors.As(err, &exitErr) {
-			return fmt.Errorf("unable to apply commit: %w", err)
+			return fmt.Errorf("%w: unable to apply commit: %w", ErrCommit, err)
 		}
 
+		// Keep the message when Git rejects the commit so it can be restored.
 		if err := setSnapshot(c.Creator, c.Snapshotter, c.Options.SnapshotFile, snap); err != nil {
 			return fmt.Errorf("unable to set snapshot: %w", err)
 		}
+
+		return fmt.Errorf("%w: unable to apply commit: %w", ErrCommit, err)
 	default:
+		// The commit replacing the message is squashed into the commit being
+		// reworded.
+		if req.Reword && !req.DryRun {
+			if err := c.Repoer.Autosquash(req.Target.Hash, req.Output); err != nil {
+				return fmt.Errorf("%w: unable to reword commit: %w", ErrCommit, err)
+			}
+		}
+
 		if err := c.Remover(c.Options.SnapshotFile); err != nil {
 			return fmt.Errorf("unable to remove snapshot: %w", err)
 		}
@@ -212,6 +358,31 @@ func (c *Commit) Apply(req *Request) error {
 	return nil
 }
 
+// revertBranch switches back from the branch created for a failed commit so
+// the user is not left on an empty branch. The error reports the new branch
+// when switching back fails.
+func (c *Commit) revertBranch(req *Request, err error) error {
+	if req.Branch == "" || req.DryRun {
+		return err
+	}
+
+	if rerr := c.Repoer.RevertBranch(req.Branch, req.Output); rerr != nil {
+		return fmt.Errorf("%w: left on new branch %v: %w", err, req.Branch, rerr)
+	}
+
+	return err
+}
+
+// Head returns the commit at the tip of the current branch.
+func (c *Commit) Head() (repository.Head, error) {
+	head, err := c.Repoer.Head()
+	if err != nil {
+		return repository.Head{}, fmt.Errorf("unable to get head: %w", err)
+	}
+
+	return head, nil
+}
+
 func getRepo(repo Repoer) (repository.Description, error) {
 	if err := repo.Open(); err != nil {
 		return repository.Description{}, fmt.Errorf("unable to open repository: %w", err)
@@ -225,6 +396,25 @@ func getRepo(repo Repoer) (repository.Description, error) {
 	return desc, nil
 }
 
+// getReword returns the commit to reword. The current branch is rebased so
+// the worktree must be clean and the commit safe to rewrite.
+func getReword(repo Repoer, desc repository.Description, rev string) (repository.Head, error) {
+	if !desc.Worktree.IsClean() {
+		return repository.Head{}, ErrDirty
+	}
+
+	h, err := repo.Lookup(rev)
+	if err != nil {
+		return repository.Head{}, fmt.Errorf("unable to get commit: %w", err)
+	}
+
+	if err := repo.CheckReword(h.Hash); err != nil {
+		return repository.Head{}, err
+	}
+
+	return h, nil
+}
+
 func getConfig(open Opener, configer Configer, file string) (config.Config, error) {
 	r, err := open(file)
 	if err != nil {
@@ -279,6 +469,59 @@ func setSnapshot(create Creator, snapshotter Snapshotter, file string, snap snap
 	return nil
 }
 
+// getSpelling creates a spell checker including the words of the user and
+// repository dictionaries.
+func getSpelling(open Opener, files ...string) (*spell.Checker, error) {
+	var words []string
+
+	for _, file := range files {
+		if file == "" {
+			continue
+		}
+
+		r, err := open(file)
+		if err != nil {
+			return nil, fmt.Errorf("unable to open dictionary: %v: %w", file, err)
+		}
+
+		ws, err := spell.Words(r)
+		if err != nil {
+			return nil, fmt.Errorf("unable to load dictionary: %w", err)
+		}
+
+		words = append(words, ws...)
+	}
+
+	return spell.New(spell.WithWords(words...)), nil
+}
+
+// getEmojiRules returns the emoji rules of the repository.
+func getEmojiRules(open Opener, file string) ([]config.EmojiRule, error) {
+	if file == "" {
+		return nil, nil
+	}
+
+	r, err := open(file)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open emoji rules: %v: %w", file, err)
+	}
+
+	rules, err := config.LoadEmojiRules(r)
+	if err != nil {
+		return nil, fmt.Errorf("unable to load emoji rules: %v: %w", file, err)
+	}
+
+	return rules, nil
+}
+
+func repoFile(repo repository.Description, name string) string {
+	if repo.Worktree.Root == "" {
+		return ""
+	}
+
+	return filepath.Join(repo.Worktree.Root, name)
+}
+
 func getEmojis(emojier Emojier, cfg config.Config) *emoji.Set {
 	prof := EmojiConfigToEmojiProfile(cfg.View.EmojiSet)
 	fn := emoji.WithEmojiSet(prof)
@@ -286,28 +529,42 @@ func getEmojis(emojier Emojier, cfg config.Config) *emoji.Set {
 	return emojier(fn)
 }
 
-func readFile(readFile ReadFiler, opts Options) (File, error) {
+func readFile(readFile ReadFiler, opts Options, cfgChar string, cleanup Cleanup) (File, error) {
 	data, err := readFile(opts.File.MessageFile)
 	if err != nil {
 		return File{}, fmt.Errorf("unable to read file: %w", err)
 	}
 
 	msg := string(data)
+	char := fileCommentChar(cfgChar, msg)
+
+	var f File
+	f.Message, f.Comments = SplitComments(msg, char, cleanup)
 
-	f := File{
-		Message: msg,
+	if opts.Mode == ModeHook {
+		f.Source = ParseSource(opts.File.Source)
 	}
 
-	if isAmend(msg, opts) {
+	if isAmend(msg, opts, char) {
 		f.Amend = true
 	}
 
 	return f, nil
 }
 
-func isAmend(msg string, opts Options) bool {
-	if opts.Mode == ModeHook && opts.File.SHA == "HEAD" {
-		return true
+func isAmend(msg string, opts Options, char string) bool {
+	if opts.Mode == ModeHook {
+		switch ParseSource(opts.File.Source) {
+		case SourceUnset:
+			// Hooks installed before the source was passed only provide the sha.
+			if opts.File.SHA == "HEAD" {
+				return true
+			}
+		case SourceCommit:
+			return opts.File.SHA == "HEAD"
+		default:
+			return false
+		}
 	}
 
 	r := strings.NewReader(msg)
@@ -315,7 +572,7 @@ func isAmend(msg string, opts Options) bool {
 	scanner := bufio.NewScanner(r)
 
 	for scanner.Scan() {
-		if !strings.HasPrefix(scanner.Text(), "#") && strings.TrimSpace(scanner.Text()) != "" {
+		if !strings.HasPrefix(scanner.Text(), char) && strings.TrimSpace(scanner.Text()) != "" {
 			return true
 		}
 	}
@@ -6,23 +6,45 @@ import (
 	"os/exec"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/mikelorant/committed/internal/commit"
 	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/generator"
+	"github.com/mikelorant/committed/internal/keymap"
 	"github.com/mikelorant/committed/internal/repository"
 	"github.com/mikelorant/committed/internal/snapshot"
+	"github.com/mikelorant/committed/internal/spell"
 
+	"github.com/go-git/go-git/v5"
 	"github.com/stretchr/testify/assert"
 )
 
 type MockRepository struct {
+	desc   repository.Description
 	com    repository.Commit
+	coms   []repository.Commit
+	head   repository.Head
+	lookup repository.Head
+	log    []repository.Head
+	unpush []repository.Head
+	branch string
+	revert string
+	squash string
 	ignore bool
 
-	openErr  error
-	descErr  error
-	applyErr error
+	openErr   error
+	descErr   error
+	applyErr  error
+	headErr   error
+	lookupErr error
+	logErr    error
+	unpushErr error
+	branchErr error
+	revertErr error
+	rewordErr error
+	squashErr error
 }
 
 func (r *MockRepository) Open() error {
@@ -30,11 +52,12 @@ func (r *MockRepository) Open() error {
 }
 
 func (r *MockRepository) Describe() (repository.Description, error) {
-	return repository.Description{}, r.descErr
+	return r.desc, r.descErr
 }
 
 func (r *MockRepository) Apply(c repository.Commit) error {
 	r.com = c
+	r.coms = append(r.coms, c)
 
 	if r.applyErr != nil {
 		return r.applyErr
@@ -43,6 +66,44 @@ func (r *MockRepository) Apply(c repository.Commit) error {
 	return nil
 }
 
+func (r *MockRepository) Head() (repository.Head, error) {
+	return r.head, r.headErr
+}
+
+func (r *MockRepository) Lookup(rev string) (repository.Head, error) {
+	return r.lookup, r.lookupErr
+}
+
+func (r *MockRepository) Log(_ string) ([]repository.Head, error) {
+	return r.log, r.logErr
+}
+
+func (r *MockRepository) Unpushed() ([]repository.Head, error) {
+	return r.unpush, r.unpushErr
+}
+
+func (r *MockRepository) CreateBranch(name string, _ io.Writer) error {
+	r.branch = name
+
+	return r.branchErr
+}
+
+func (r *MockRepository) RevertBranch(name string, _ io.Writer) error {
+	r.revert = name
+
+	return r.revertErr
+}
+
+func (r *MockRepository) CheckReword(_ string) error {
+	return r.rewordErr
+}
+
+func (r *MockRepository) Autosquash(hash string, _ io.Writer) error {
+	r.squash = hash
+
+	return r.squashErr
+}
+
 func (r *MockRepository) IgnoreGlobalConfig() {
 	r.ignore = true
 }
@@ -160,6 +221,10 @@ func TestConfigure(t *testing.T) {
 		saveErr     error
 		snapLoadErr error
 		readFileErr error
+		lookup      repository.Head
+		lookupErr   error
+		rewordErr   error
+		desc        repository.Description
 	}
 
 	type want struct {
@@ -179,6 +244,7 @@ func TestConfigure(t *testing.T) {
 			want: want{
 				state: commit.State{
 					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
 					Config:       config.Config{},
 					Emojis:       &emoji.Set{},
 				},
@@ -194,6 +260,7 @@ func TestConfigure(t *testing.T) {
 			want: want{
 				state: commit.State{
 					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
 					Config:       config.Config{},
 					Emojis:       &emoji.Set{},
 					Options: commit.Options{
@@ -212,6 +279,7 @@ func TestConfigure(t *testing.T) {
 			want: want{
 				state: commit.State{
 					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
 					Config:       config.Config{},
 					Emojis:       &emoji.Set{},
 					Options: commit.Options{
@@ -232,6 +300,7 @@ func TestConfigure(t *testing.T) {
 			want: want{
 				state: commit.State{
 					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
 					Config: config.Config{
 						View: config.View{
 							Focus: config.FocusAuthor,
@@ -256,6 +325,7 @@ func TestConfigure(t *testing.T) {
 			want: want{
 				state: commit.State{
 					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
 					Config:       config.Config{},
 					Emojis:       &emoji.Set{},
 					Options: commit.Options{
@@ -284,6 +354,7 @@ func TestConfigure(t *testing.T) {
 			want: want{
 				state: commit.State{
 					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
 					Config:       config.Config{},
 					Emojis:       &emoji.Set{},
 					Snapshot: snapshot.Snapshot{
@@ -314,71 +385,555 @@ func TestConfigure(t *testing.T) {
 			},
 			want: want{
 				state: commit.State{
-					Placeholders: testPlaceholders(),
-					Config:       config.Config{},
-					Emojis:       &emoji.Set{},
-					Options: commit.Options{
-						Mode: commit.ModeHook,
-						File: commit.FileOptions{
-							MessageFile: "test",
+					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
+					Config:       config.Config{},
+					Emojis:       &emoji.Set{},
+					Options: commit.Options{
+						Mode: commit.ModeHook,
+						File: commit.FileOptions{
+							MessageFile: "test",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "file_hook_sha",
+			args: args{
+				opts: commit.Options{
+					Mode: commit.ModeHook,
+					File: commit.FileOptions{
+						MessageFile: "test",
+						SHA:         "HEAD",
+					},
+				},
+			},
+			want: want{
+				state: commit.State{
+					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
+					Config:       config.Config{},
+					Emojis:       &emoji.Set{},
+					Options: commit.Options{
+						Mode: commit.ModeHook,
+						File: commit.FileOptions{
+							MessageFile: "test",
+							SHA:         "HEAD",
+						},
+						Amend: true,
+					},
+					File: commit.File{
+						Amend: true,
+					},
+				},
+			},
+		},
+		{
+			name: "file_hook_source_message",
+			args: args{
+				opts: commit.Options{
+					Mode: commit.ModeHook,
+					File: commit.FileOptions{
+						MessageFile: "test",
+						Source:      "message",
+					},
+				},
+				data: "summary",
+			},
+			want: want{
+				state: commit.State{
+					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
+					Config:       config.Config{},
+					Emojis:       &emoji.Set{},
+					Options: commit.Options{
+						Mode: commit.ModeHook,
+						File: commit.FileOptions{
+							MessageFile: "test",
+							Source:      "message",
+						},
+					},
+					File: commit.File{
+						Message: "summary",
+						Source:  commit.SourceMessage,
+					},
+				},
+			},
+		},
+		{
+			name: "file_hook_source_merge",
+			args: args{
+				opts: commit.Options{
+					Mode: commit.ModeHook,
+					File: commit.FileOptions{
+						MessageFile: "test",
+						Source:      "merge",
+					},
+				},
+				data: "Merge branch 'feature'",
+			},
+			want: want{
+				state: commit.State{
+					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
+					Config:       config.Config{},
+					Emojis:       &emoji.Set{},
+					Options: commit.Options{
+						Mode: commit.ModeHook,
+						File: commit.FileOptions{
+							MessageFile: "test",
+							Source:      "merge",
+						},
+					},
+					File: commit.File{
+						Message: "Merge branch 'feature'",
+						Source:  commit.SourceMerge,
+					},
+				},
+			},
+		},
+		{
+			name: "file_hook_source_commit_head",
+			args: args{
+				opts: commit.Options{
+					Mode: commit.ModeHook,
+					File: commit.FileOptions{
+						MessageFile: "test",
+						Source:      "commit",
+						SHA:         "HEAD",
+					},
+				},
+				data: "summary",
+			},
+			want: want{
+				state: commit.State{
+					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
+					Config:       config.Config{},
+					Emojis:       &emoji.Set{},
+					Options: commit.Options{
+						Mode: commit.ModeHook,
+						File: commit.FileOptions{
+							MessageFile: "test",
+							Source:      "commit",
+							SHA:         "HEAD",
+						},
+						Amend: true,
+					},
+					File: commit.File{
+						Amend:   true,
+						Message: "summary",
+						Source:  commit.SourceCommit,
+					},
+				},
+			},
+		},
+		{
+			name: "file_hook_source_commit_sha",
+			args: args{
+				opts: commit.Options{
+					Mode: commit.ModeHook,
+					File: commit.FileOptions{
+						MessageFile: "test",
+						Source:      "commit",
+						SHA:         "1234567",
+					},
+				},
+				data: "\n# comment",
+				lookup: repository.Head{
+					Hash:    "1234567890abcdef1234567890abcdef12345678",
+					Message: "summary\n\nbody",
+				},
+			},
+			want: want{
+				state: commit.State{
+					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
+					Config:       config.Config{},
+					Emojis:       &emoji.Set{},
+					Options: commit.Options{
+						Mode: commit.ModeHook,
+						File: commit.FileOptions{
+							MessageFile: "test",
+							Source:      "commit",
+							SHA:         "1234567",
+						},
+					},
+					File: commit.File{
+						Message:  "summary\n\nbody",
+						Comments: "# comment",
+						Source:   commit.SourceCommit,
+						Commit: repository.Head{
+							Hash:    "1234567890abcdef1234567890abcdef12345678",
+							Message: "summary\n\nbody",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "file_hook_comment_char_auto",
+			args: args{
+				opts: commit.Options{
+					Mode: commit.ModeHook,
+					File: commit.FileOptions{
+						MessageFile: "test",
+					},
+				},
+				desc: repository.Description{CommentChar: "auto"},
+				data: "\n\n; Please enter the commit message for your changes.\n;\n; On branch master",
+			},
+			want: want{
+				state: commit.State{
+					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
+					Config:       config.Config{},
+					Emojis:       &emoji.Set{},
+					Repository:   repository.Description{CommentChar: "auto"},
+					Options: commit.Options{
+						Mode: commit.ModeHook,
+						File: commit.FileOptions{
+							MessageFile: "test",
+						},
+					},
+					File: commit.File{
+						Comments: "; Please enter the commit message for your changes.\n;\n; On branch master",
+					},
+				},
+			},
+		},
+		{
+			name: "file_editor_cleanup_whitespace",
+			args: args{
+				opts: commit.Options{
+					Mode: commit.ModeEditor,
+					File: commit.FileOptions{
+						MessageFile: "test",
+					},
+				},
+				desc: repository.Description{Cleanup: "whitespace"},
+				data: "summary\n\n# heading\n",
+			},
+			want: want{
+				state: commit.State{
+					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
+					Config:       config.Config{},
+					Emojis:       &emoji.Set{},
+					Repository:   repository.Description{Cleanup: "whitespace"},
+					Options: commit.Options{
+						Mode:  commit.ModeEditor,
+						Amend: true,
+						File: commit.FileOptions{
+							MessageFile: "test",
+						},
+					},
+					File: commit.File{
+						Amend:   true,
+						Message: "summary\n\n# heading",
+					},
+				},
+			},
+		},
+		{
+			name: "author_rule",
+			args: args{
+				cfg: config.Config{
+					AuthorRules: []config.AuthorRule{
+						{Author: repository.User{Email: "john.doe@example.com"}, Remotes: []string{"github.com/john/*"}},
+						{Author: repository.User{Email: "john.doe@acme.com"}, Remotes: []string{"github.com/acme/*"}},
+					},
+				},
+				desc: repository.Description{RemoteURLs: []string{"git@github.com:acme/widget.git"}},
+			},
+			want: want{
+				state: commit.State{
+					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
+					Config: config.Config{
+						AuthorRules: []config.AuthorRule{
+							{Author: repository.User{Email: "john.doe@example.com"}, Remotes: []string{"github.com/john/*"}},
+							{Author: repository.User{Email: "john.doe@acme.com"}, Remotes: []string{"github.com/acme/*"}},
+						},
+					},
+					Emojis:     &emoji.Set{},
+					Repository: repository.Description{RemoteURLs: []string{"git@github.com:acme/widget.git"}},
+					AuthorRule: &config.AuthorRule{
+						Author:  repository.User{Email: "john.doe@acme.com"},
+						Remotes: []string{"github.com/acme/*"},
+					},
+				},
+				cfg: config.Config{
+					AuthorRules: []config.AuthorRule{
+						{Author: repository.User{Email: "john.doe@example.com"}, Remotes: []string{"github.com/john/*"}},
+						{Author: repository.User{Email: "john.doe@acme.com"}, Remotes: []string{"github.com/acme/*"}},
+					},
+				},
+			},
+		},
+		{
+			name: "identity",
+			args: args{
+				opts: commit.Options{
+					Identity: commit.IdentityOptions{
+						Committer:     "John Doe <john.doe@example.com>",
+						CommitterDate: "@1640998800",
+					},
+				},
+			},
+			want: want{
+				state: commit.State{
+					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
+					Config:       config.Config{},
+					Emojis:       &emoji.Set{},
+					Options: commit.Options{
+						Identity: commit.IdentityOptions{
+							Committer:     "John Doe <john.doe@example.com>",
+							CommitterDate: "@1640998800",
+						},
+					},
+					Identity: commit.Identity{
+						Committer:     repository.User{Name: "John Doe", Email: "john.doe@example.com"},
+						CommitterDate: time.Unix(1640998800, 0),
+					},
+				},
+			},
+		},
+		{
+			name: "file_hook_identity",
+			args: args{
+				opts: commit.Options{
+					Mode: commit.ModeHook,
+					File: commit.FileOptions{
+						MessageFile: "test",
+					},
+					Identity: commit.IdentityOptions{
+						AuthorDate: "invalid",
+						Committer:  "John Doe <john.doe@example.com>",
+					},
+				},
+			},
+			want: want{
+				state: commit.State{
+					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
+					Config:       config.Config{},
+					Emojis:       &emoji.Set{},
+					Options: commit.Options{
+						Mode: commit.ModeHook,
+						File: commit.FileOptions{
+							MessageFile: "test",
+						},
+						Identity: commit.IdentityOptions{
+							AuthorDate: "invalid",
+							Committer:  "John Doe <john.doe@example.com>",
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "identity_error",
+			args: args{
+				opts: commit.Options{
+					Identity: commit.IdentityOptions{AuthorDate: "invalid"},
+				},
+			},
+			want: want{
+				err: "unable to get identity: invalid date: invalid",
+			},
+		},
+		{
+			name: "file_hook_source_commit_error",
+			args: args{
+				opts: commit.Options{
+					Mode: commit.ModeHook,
+					File: commit.FileOptions{
+						MessageFile: "test",
+						Source:      "commit",
+						SHA:         "1234567",
+					},
+				},
+				lookupErr: errMock,
+			},
+			want: want{
+				err: "unable to get source commit: error",
+			},
+		},
+		{
+			name: "reword",
+			args: args{
+				opts: commit.Options{
+					Reword: "HEAD~1",
+				},
+				lookup: repository.Head{
+					Hash:    "1234567890abcdef1234567890abcdef12345678",
+					Message: "summary",
+				},
+			},
+			want: want{
+				state: commit.State{
+					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
+					Config:       config.Config{},
+					Emojis:       &emoji.Set{},
+					Options: commit.Options{
+						Reword: "HEAD~1",
+					},
+					Reword: repository.Head{
+						Hash:    "1234567890abcdef1234567890abcdef12345678",
+						Message: "summary",
+					},
+				},
+			},
+		},
+		{
+			name: "reword_dirty",
+			args: args{
+				opts: commit.Options{
+					Reword: "HEAD~1",
+				},
+				desc: repository.Description{
+					Worktree: repository.Worktree{
+						Status: git.Status{
+							"main.go": &git.FileStatus{Staging: git.Modified},
+						},
+					},
+				},
+			},
+			want: want{
+				err: "invalid reword: worktree has changes",
+			},
+		},
+		{
+			name: "reword_lookup_error",
+			args: args{
+				opts: commit.Options{
+					Reword: "HEAD~1",
+				},
+				lookupErr: errMock,
+			},
+			want: want{
+				err: "invalid reword: unable to get commit: error",
+			},
+		},
+		{
+			name: "reword_unsafe",
+			args: args{
+				opts: commit.Options{
+					Reword: "HEAD~1",
+				},
+				rewordErr: repository.ErrRewordPushed,
+			},
+			want: want{
+				err: "invalid reword: commit has been pushed",
+			},
+		},
+		{
+			name: "ignore_global_config",
+			args: args{
+				cfg: config.Config{
+					View: config.View{
+						IgnoreGlobalAuthor: true,
+					},
+				},
+			},
+			want: want{
+				cfg: config.Config{
+					View: config.View{
+						IgnoreGlobalAuthor: true,
+					},
+				},
+				state: commit.State{
+					Config: config.Config{
+						View: config.View{
+							IgnoreGlobalAuthor: true,
+						},
+					},
+					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
+					Emojis:       &emoji.Set{},
+				},
+			},
+		},
+		{
+			name: "spell_check",
+			args: args{
+				cfg: config.Config{
+					View: config.View{
+						SpellCheck: true,
+					},
+				},
+				opts: commit.Options{
+					DictionaryFile: "test",
+				},
+			},
+			want: want{
+				state: commit.State{
+					Config: config.Config{
+						View: config.View{
+							SpellCheck: true,
 						},
 					},
+					Options: commit.Options{
+						DictionaryFile: "test",
+					},
+					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
+					Emojis:       &emoji.Set{},
+					Spelling:     spell.New(),
 				},
 			},
 		},
 		{
-			name: "file_hook_sha",
+			name: "generators",
 			args: args{
-				opts: commit.Options{
-					Mode: commit.ModeHook,
-					File: commit.FileOptions{
-						MessageFile: "test",
-						SHA:         "HEAD",
+				cfg: config.Config{
+					Generators: []config.Generator{
+						{Name: "test", Command: "test.sh"},
 					},
 				},
 			},
 			want: want{
 				state: commit.State{
-					Placeholders: testPlaceholders(),
-					Config:       config.Config{},
-					Emojis:       &emoji.Set{},
-					Options: commit.Options{
-						Mode: commit.ModeHook,
-						File: commit.FileOptions{
-							MessageFile: "test",
-							SHA:         "HEAD",
+					Config: config.Config{
+						Generators: []config.Generator{
+							{Name: "test", Command: "test.sh"},
 						},
-						Amend: true,
 					},
-					File: commit.File{
-						Amend: true,
+					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
+					Emojis:       &emoji.Set{},
+					Generators: []generator.Generator{
+						generator.Command{Name: "test", Command: "test.sh"},
 					},
 				},
 			},
 		},
 		{
-			name: "ignore_global_config",
+			name: "emoji_rules",
 			args: args{
 				cfg: config.Config{
-					View: config.View{
-						IgnoreGlobalAuthor: true,
+					EmojiRules: []config.EmojiRule{
+						{Emoji: ":fire:", Change: config.ChangeDeleted, All: true},
 					},
 				},
 			},
 			want: want{
-				cfg: config.Config{
-					View: config.View{
-						IgnoreGlobalAuthor: true,
-					},
-				},
 				state: commit.State{
 					Config: config.Config{
-						View: config.View{
-							IgnoreGlobalAuthor: true,
+						EmojiRules: []config.EmojiRule{
+							{Emoji: ":fire:", Change: config.ChangeDeleted, All: true},
 						},
 					},
 					Placeholders: testPlaceholders(),
+					KeyMap:       keymap.Default(),
 					Emojis:       &emoji.Set{},
+					EmojiRules: []config.EmojiRule{
+						{Emoji: ":fire:", Change: config.ChangeDeleted, All: true},
+					},
 				},
 			},
 		},
@@ -409,7 +964,7 @@ func TestConfigure(t *testing.T) {
 				openErr: errMock,
 			},
 			want: want{
-				err: "unable to get config: unable to open config file: test: error",
+				err: "invalid config: unable to get config: unable to open config file: test: error",
 			},
 		},
 		{
@@ -418,7 +973,22 @@ func TestConfigure(t *testing.T) {
 				configErr: errMock,
 			},
 			want: want{
-				err: "unable to get config: unable to load config file: error",
+				err: "invalid config: unable to get config: unable to load config file: error",
+			},
+		},
+		{
+			name: "keymap_error",
+			args: args{
+				cfg: config.Config{
+					Keys: config.Keys{
+						Bindings: map[string]config.KeyList{
+							"amend": {"ctrl+c"},
+						},
+					},
+				},
+			},
+			want: want{
+				err: "invalid config: unable to get key map: key conflict: ctrl+c: amend and cancel",
 			},
 		},
 		{
@@ -462,8 +1032,12 @@ func TestConfigure(t *testing.T) {
 			}
 
 			repo := MockRepository{
-				openErr: tt.args.repoOpenErr,
-				descErr: tt.args.repoDescErr,
+				desc:      tt.args.desc,
+				lookup:    tt.args.lookup,
+				openErr:   tt.args.repoOpenErr,
+				descErr:   tt.args.repoDescErr,
+				lookupErr: tt.args.lookupErr,
+				rewordErr: tt.args.rewordErr,
 			}
 
 			c := commit.Commit{
@@ -497,6 +1071,9 @@ func TestApply(t *testing.T) {
 		removeErr   error
 		saveErr     error
 		applyErr    error
+		branchErr   error
+		revertErr   error
+		squashErr   error
 		snapSaveErr error
 		nilReq      bool
 	}
@@ -506,6 +1083,9 @@ func TestApply(t *testing.T) {
 		com    repository.Commit
 		snap   snapshot.Snapshot
 		snapRm bool
+		branch string
+		revert string
+		squash string
 		err    string
 	}
 
@@ -539,6 +1119,63 @@ func TestApply(t *testing.T) {
 				snapRm: true,
 			},
 		},
+		{
+			name: "identity",
+			args: args{
+				req: &commit.Request{
+					Apply:      true,
+					AuthorDate: time.Date(2022, time.January, 1, 1, 0, 0, 0, time.UTC),
+					Committer: repository.User{
+						Name:  "Jane Doe",
+						Email: "jane.doe@example.com",
+					},
+					CommitterDate: time.Date(2022, time.January, 2, 1, 0, 0, 0, time.UTC),
+				},
+			},
+			want: want{
+				com: repository.Commit{
+					Date: time.Date(2022, time.January, 1, 1, 0, 0, 0, time.UTC),
+					Committer: repository.User{
+						Name:  "Jane Doe",
+						Email: "jane.doe@example.com",
+					},
+					CommitterDate: time.Date(2022, time.January, 2, 1, 0, 0, 0, time.UTC),
+				},
+				snapRm: true,
+			},
+		},
+		{
+			name: "body_comment",
+			args: args{
+				req: &commit.Request{
+					Apply: true,
+					Body:  "body\n# heading  ",
+				},
+			},
+			want: want{
+				com: repository.Commit{
+					Body: "body\n# heading",
+				},
+				snapRm: true,
+			},
+		},
+		{
+			name: "file_body_comment",
+			args: args{
+				req: &commit.Request{
+					Apply: true,
+					Body:  "body\n# heading",
+					File:  true,
+				},
+			},
+			want: want{
+				com: repository.Commit{
+					Body: "body",
+					File: true,
+				},
+				snapRm: true,
+			},
+		},
 		{
 			name: "dryrun",
 			args: args{
@@ -644,6 +1281,218 @@ func TestApply(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "save_reword",
+			args: args{
+				req: &commit.Request{
+					Apply:   false,
+					Summary: "summary",
+					Reword:  true,
+				},
+			},
+			want: want{
+				snap: snapshot.Snapshot{
+					Summary: "summary",
+				},
+			},
+		},
+		{
+			name: "branch",
+			args: args{
+				req: &commit.Request{
+					Apply:   true,
+					Summary: "summary",
+					Branch:  "summary",
+				},
+			},
+			want: want{
+				com: repository.Commit{
+					Subject: "summary",
+				},
+				snapRm: true,
+				branch: "summary",
+			},
+		},
+		{
+			name: "branch_dry_run",
+			args: args{
+				req: &commit.Request{
+					Apply:   true,
+					Summary: "summary",
+					Branch:  "summary",
+					DryRun:  true,
+				},
+			},
+			want: want{
+				com: repository.Commit{
+					Subject: "summary",
+					DryRun:  true,
+				},
+				snapRm: true,
+			},
+		},
+		{
+			name: "branch_error",
+			args: args{
+				req: &commit.Request{
+					Apply:   true,
+					Summary: "summary",
+					Branch:  "summary",
+				},
+				branchErr: errMock,
+			},
+			want: want{
+				snap: snapshot.Snapshot{
+					Summary: "summary",
+					Restore: true,
+				},
+				err: "commit failed: unable to create branch: error",
+			},
+		},
+		{
+			name: "branch_apply_error",
+			args: args{
+				req: &commit.Request{
+					Apply:   true,
+					Summary: "summary",
+					Branch:  "summary",
+				},
+				applyErr: errMockExit,
+			},
+			want: want{
+				snap: snapshot.Snapshot{
+					Summary: "summary",
+					Restore: true,
+				},
+				branch: "summary",
+				revert: "summary",
+				err:    "commit failed: unable to apply commit",
+			},
+		},
+		{
+			name: "branch_revert_error",
+			args: args{
+				req: &commit.Request{
+					Apply:   true,
+					Summary: "summary",
+					Branch:  "summary",
+				},
+				applyErr:  errMock,
+				revertErr: errMock,
+			},
+			want: want{
+				branch: "summary",
+				revert: "summary",
+				err:    "commit failed: unable to apply commit: error: left on new branch summary: error",
+			},
+		},
+		{
+			name: "fixup",
+			args: args{
+				req: &commit.Request{
+					Apply:  true,
+					Emoji:  "🐛",
+					Fixup:  commit.FixupFixup,
+					Target: repository.Head{Message: "Add the parser\n\nBody\n"},
+				},
+			},
+			want: want{
+				com: repository.Commit{
+					Subject: "fixup! Add the parser",
+				},
+				snapRm: true,
+			},
+		},
+		{
+			name: "amend_fixup",
+			args: args{
+				req: &commit.Request{
+					Apply:   true,
+					Emoji:   "🐛",
+					Summary: "Add the parser for config files",
+					Body:    "Body",
+					Fixup:   commit.FixupAmend,
+					Target:  repository.Head{Message: "Add the parser\n"},
+				},
+			},
+			want: want{
+				com: repository.Commit{
+					Subject: "amend! Add the parser",
+					Body:    "🐛 Add the parser for config files\n\nBody",
+				},
+				snapRm: true,
+			},
+		},
+		{
+			name: "reword",
+			args: args{
+				req: &commit.Request{
+					Apply:   true,
+					Summary: "Add the parser for config files",
+					Fixup:   commit.FixupAmend,
+					Target: repository.Head{
+						Hash:    "1234567890abcdef1234567890abcdef12345678",
+						Message: "Add the parser\n",
+					},
+					Reword: true,
+				},
+			},
+			want: want{
+				com: repository.Commit{
+					Subject:    "amend! Add the parser",
+					Body:       "Add the parser for config files",
+					AllowEmpty: true,
+					NoHooks:    true,
+				},
+				snapRm: true,
+				squash: "1234567890abcdef1234567890abcdef12345678",
+			},
+		},
+		{
+			name: "reword_dry_run",
+			args: args{
+				req: &commit.Request{
+					Apply:   true,
+					Summary: "Add the parser for config files",
+					Fixup:   commit.FixupAmend,
+					Target: repository.Head{
+						Hash:    "1234567890abcdef1234567890abcdef12345678",
+						Message: "Add the parser\n",
+					},
+					Reword: true,
+					DryRun: true,
+				},
+			},
+			want: want{
+				com: repository.Commit{
+					Subject:    "amend! Add the parser",
+					Body:       "Add the parser for config files",
+					AllowEmpty: true,
+					NoHooks:    true,
+					DryRun:     true,
+				},
+				snapRm: true,
+			},
+		},
+		{
+			name: "reword_error",
+			args: args{
+				req: &commit.Request{
+					Apply:   true,
+					Summary: "Add the parser for config files",
+					Fixup:   commit.FixupAmend,
+					Target: repository.Head{
+						Hash:    "1234567890abcdef1234567890abcdef12345678",
+						Message: "Add the parser\n",
+					},
+					Reword: true,
+				},
+				squashErr: errMock,
+			},
+			want: want{
+				err: "commit failed: unable to reword commit: error",
+			},
+		},
 		{
 			name: "no_request",
 			args: args{
@@ -712,6 +1561,7 @@ func TestApply(t *testing.T) {
 				snap: snapshot.Snapshot{
 					Restore: true,
 				},
+				err: "commit failed: unable to apply commit",
 			},
 		},
 		{
@@ -727,6 +1577,34 @@ func TestApply(t *testing.T) {
 				err: "unable to set snapshot: unable to save snapshot: error",
 			},
 		},
+		{
+			name: "headless",
+			args: args{
+				req: &commit.Request{
+					Apply:    true,
+					Summary:  "summary",
+					Headless: true,
+				},
+			},
+			want: want{
+				com: repository.Commit{
+					Subject: "summary",
+				},
+			},
+		},
+		{
+			name: "headless_exit_error",
+			args: args{
+				req: &commit.Request{
+					Apply:    true,
+					Headless: true,
+				},
+				applyErr: errMockExit,
+			},
+			want: want{
+				err: "unable to apply commit",
+			},
+		},
 		{
 			name: "snapshot_remove_error",
 			args: args{
@@ -776,7 +1654,10 @@ func TestApply(t *testing.T) {
 			t.Parallel()
 
 			repo := MockRepository{
-				applyErr: tt.args.applyErr,
+				applyErr:  tt.args.applyErr,
+				branchErr: tt.args.branchErr,
+				revertErr: tt.args.revertErr,
+				squashErr: tt.args.squashErr,
 			}
 
 			cfg := MockConfig{
@@ -808,6 +1689,8 @@ func TestApply(t *testing.T) {
 			if tt.want.err != "" {
 				assert.NotNil(t, err)
 				assert.ErrorContains(t, err, tt.want.err)
+				assert.Equal(t, tt.want.snap, snap.snap)
+				assert.Equal(t, tt.want.revert, repo.revert)
 				return
 			}
 			assert.Nil(t, err)
@@ -815,6 +1698,9 @@ func TestApply(t *testing.T) {
 			assert.Equal(t, tt.want.snap, snap.snap)
 			assert.Equal(t, tt.want.cfg, cfg.file)
 			assert.Equal(t, tt.want.snapRm, rm.called)
+			assert.Equal(t, tt.want.branch, repo.branch)
+			assert.Equal(t, tt.want.revert, repo.revert)
+			assert.Equal(t, tt.want.squash, repo.squash)
 		})
 	}
 }
@@ -824,6 +1710,6 @@ func testPlaceholders() commit.Placeholders {
 		Hash:    commit.PlaceholderHash,
 		Summary: commit.PlaceholderSummary,
 		Body:    commit.PlaceholderMessage,
-		Help:    commit.PlaceholderHelp,
+		Help:    commit.PlaceholderHelp(keymap.Default()),
 	}
 }
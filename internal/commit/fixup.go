@@ -0,0 +1,79 @@
+package commit
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Fixup is the kind of commit created to be combined with an earlier commit
+// by git rebase --autosquash.
+type Fixup int
+
+const (
+	FixupUnset Fixup = iota
+	FixupFixup
+	FixupSquash
+	FixupAmend
+)
+
+func (f Fixup) String() string {
+	return []string{
+		"",
+		"fixup",
+		"squash",
+		"amend",
+	}[f]
+}
+
+// Next returns the kind following the fixup, wrapping around to the first.
+func (f Fixup) Next() Fixup {
+	if f >= FixupAmend {
+		return FixupFixup
+	}
+
+	return f + 1
+}
+
+// RequiresSummary reports whether the summary is needed. Fixup and squash
+// commits take the subject of the target while amend commits replace the
+// message of the target with the one written.
+func (f Fixup) RequiresSummary() bool {
+	return f == FixupUnset || f == FixupAmend
+}
+
+// FixupMessage returns the subject and body of the commit. The subject refers
+// to the target as Git does so the commit is moved below it by an autosquash
+// rebase. The message written is kept as the body, without the emoji when
+// there is no summary.
+func FixupMessage(f Fixup, target, emoji, summary, body string) (string, string) {
+	subject := EmojiSummaryToSubject(emoji, summary)
+
+	if f == FixupUnset {
+		return subject, body
+	}
+
+	var ps []string
+
+	if summary != "" {
+		ps = append(ps, subject)
+	}
+
+	if body != "" {
+		ps = append(ps, body)
+	}
+
+	return fmt.Sprintf("%s! %s", f, MessageToSubject(target)), strings.Join(ps, "\n\n")
+}
+
+// MessageToSubject returns the subject of the message as formatted by Git
+// with the lines of the first paragraph joined by spaces.
+func MessageToSubject(msg string) string {
+	para, _, _ := strings.Cut(strings.TrimSpace(msg), "\n\n")
+
+	ls := strings.Split(para, "\n")
+	for i, l := range ls {
+		ls[i] = strings.TrimSpace(l)
+	}
+
+	return strings.Join(ls, " ")
+}
@@ -0,0 +1,156 @@
+package commit_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFixupNext(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		fixup commit.Fixup
+		want  commit.Fixup
+	}{
+		{name: "unset", fixup: commit.FixupUnset, want: commit.FixupFixup},
+		{name: "fixup", fixup: commit.FixupFixup, want: commit.FixupSquash},
+		{name: "squash", fixup: commit.FixupSquash, want: commit.FixupAmend},
+		{name: "amend", fixup: commit.FixupAmend, want: commit.FixupFixup},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.fixup.Next())
+		})
+	}
+}
+
+func TestFixupMessage(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		fixup   commit.Fixup
+		target  string
+		emoji   string
+		summary string
+		body    string
+	}
+
+	type want struct {
+		subject string
+		body    string
+		summary bool
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "unset",
+			args: args{
+				target:  "Add the parser",
+				emoji:   "🐛",
+				summary: "Fix crash",
+				body:    "Body",
+			},
+			want: want{
+				subject: "🐛 Fix crash",
+				body:    "Body",
+				summary: true,
+			},
+		},
+		{
+			name: "fixup",
+			args: args{
+				fixup:  commit.FixupFixup,
+				target: "✨ Add the parser\n\nBody of the target.\n",
+				emoji:  "🐛",
+			},
+			want: want{
+				subject: "fixup! ✨ Add the parser",
+			},
+		},
+		{
+			name: "fixup_body",
+			args: args{
+				fixup:  commit.FixupFixup,
+				target: "Add the parser",
+				body:   "Handle empty input.",
+			},
+			want: want{
+				subject: "fixup! Add the parser",
+				body:    "Handle empty input.",
+			},
+		},
+		{
+			name: "squash",
+			args: args{
+				fixup:   commit.FixupSquash,
+				target:  "Add the parser",
+				emoji:   "🐛",
+				summary: "Handle empty input",
+				body:    "Body",
+			},
+			want: want{
+				subject: "squash! Add the parser",
+				body:    "🐛 Handle empty input\n\nBody",
+			},
+		},
+		{
+			name: "amend",
+			args: args{
+				fixup:   commit.FixupAmend,
+				target:  "Add the parser",
+				summary: "Add the parser for config files",
+			},
+			want: want{
+				subject: "amend! Add the parser",
+				body:    "Add the parser for config files",
+				summary: true,
+			},
+		},
+		{
+			name: "target_fixup",
+			args: args{
+				fixup:  commit.FixupFixup,
+				target: "fixup! Add the parser",
+			},
+			want: want{
+				subject: "fixup! fixup! Add the parser",
+			},
+		},
+		{
+			name: "target_paragraph",
+			args: args{
+				fixup:  commit.FixupFixup,
+				target: "Add the parser\nfor config files\n\nBody",
+			},
+			want: want{
+				subject: "fixup! Add the parser for config files",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			subject, body := commit.FixupMessage(tt.args.fixup, tt.args.target, tt.args.emoji, tt.args.summary, tt.args.body)
+			assert.Equal(t, tt.want.subject, subject)
+			assert.Equal(t, tt.want.body, body)
+			assert.Equal(t, tt.want.summary, tt.args.fixup.RequiresSummary())
+		})
+	}
+}
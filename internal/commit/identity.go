@@ -0,0 +1,92 @@
+package commit
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/mikelorant/committed/internal/repository"
+)
+
+// Identity overrides the author date and the committer of the commit. Zero
+// values use the defaults of Git.
+type Identity struct {
+	AuthorDate    time.Time
+	Committer     repository.User
+	CommitterDate time.Time
+}
+
+// IdentityOptions are the unparsed identity overrides.
+type IdentityOptions struct {
+	AuthorDate    string
+	Committer     string
+	CommitterDate string
+}
+
+var (
+	ErrDate      = errors.New("invalid date")
+	ErrCommitter = errors.New("invalid committer")
+)
+
+// dateLayouts are the date formats accepted in addition to a Unix timestamp
+// prefixed with "@".
+var dateLayouts = []string{
+	time.RFC3339,
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05 -0700",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+	time.RFC1123Z,
+	"Mon Jan 2 15:04:05 2006 -0700",
+}
+
+// NewIdentity parses the identity overrides.
+func NewIdentity(opts IdentityOptions) (Identity, error) {
+	var id Identity
+	var err error
+
+	if opts.AuthorDate != "" {
+		if id.AuthorDate, err = ParseDate(opts.AuthorDate); err != nil {
+			return Identity{}, err
+		}
+	}
+
+	if opts.Committer != "" {
+		if id.Committer, err = AuthorToUser(opts.Committer); err != nil {
+			return Identity{}, fmt.Errorf("%w: %v", ErrCommitter, opts.Committer)
+		}
+	}
+
+	if opts.CommitterDate != "" {
+		if id.CommitterDate, err = ParseDate(opts.CommitterDate); err != nil {
+			return Identity{}, err
+		}
+	}
+
+	return id, nil
+}
+
+// ParseDate parses a date in one of the formats understood by Git. Dates
+// without a time zone are local.
+func ParseDate(str string) (time.Time, error) {
+	str = strings.TrimSpace(str)
+
+	if ts, ok := strings.CutPrefix(str, "@"); ok {
+		sec, err := strconv.ParseInt(ts, 10, 64)
+		if err != nil {
+			return time.Time{}, fmt.Errorf("%w: %v", ErrDate, str)
+		}
+
+		return time.Unix(sec, 0), nil
+	}
+
+	for _, l := range dateLayouts {
+		if t, err := time.ParseInLocation(l, str, time.Local); err == nil {
+			return t, nil
+		}
+	}
+
+	return time.Time{}, fmt.Errorf("%w: %v", ErrDate, str)
+}
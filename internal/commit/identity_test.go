@@ -0,0 +1,152 @@
+package commit_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewIdentity(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		identity commit.Identity
+		err      string
+	}
+
+	tests := []struct {
+		name string
+		opts commit.IdentityOptions
+		want want
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "author_date",
+			opts: commit.IdentityOptions{AuthorDate: "2022-01-01T01:00:00Z"},
+			want: want{
+				identity: commit.Identity{
+					AuthorDate: time.Date(2022, time.January, 1, 1, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "committer",
+			opts: commit.IdentityOptions{
+				Committer:     "Jane Doe <jane.doe@example.com>",
+				CommitterDate: "2022-01-02T01:00:00Z",
+			},
+			want: want{
+				identity: commit.Identity{
+					Committer:     repository.User{Name: "Jane Doe", Email: "jane.doe@example.com"},
+					CommitterDate: time.Date(2022, time.January, 2, 1, 0, 0, 0, time.UTC),
+				},
+			},
+		},
+		{
+			name: "invalid_author_date",
+			opts: commit.IdentityOptions{AuthorDate: "yesterday"},
+			want: want{
+				err: "invalid date: yesterday",
+			},
+		},
+		{
+			name: "invalid_committer",
+			opts: commit.IdentityOptions{Committer: "jane.doe@example.com"},
+			want: want{
+				err: "invalid committer: jane.doe@example.com",
+			},
+		},
+		{
+			name: "invalid_committer_date",
+			opts: commit.IdentityOptions{CommitterDate: "tomorrow"},
+			want: want{
+				err: "invalid date: tomorrow",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			id, err := commit.NewIdentity(tt.opts)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.identity, id)
+		})
+	}
+}
+
+func TestParseDate(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		str  string
+		want time.Time
+		err  string
+	}{
+		{
+			name: "rfc3339",
+			str:  "2022-01-01T01:00:00+10:00",
+			want: time.Date(2022, time.January, 1, 1, 0, 0, 0, time.FixedZone("", 36000)),
+		},
+		{
+			name: "iso_offset",
+			str:  "2022-01-01 01:00:00 +1000",
+			want: time.Date(2022, time.January, 1, 1, 0, 0, 0, time.FixedZone("", 36000)),
+		},
+		{
+			name: "rfc2822",
+			str:  "Sat, 01 Jan 2022 01:00:00 +1000",
+			want: time.Date(2022, time.January, 1, 1, 0, 0, 0, time.FixedZone("", 36000)),
+		},
+		{
+			name: "git",
+			str:  "Sat Jan 1 01:00:00 2022 +1000",
+			want: time.Date(2022, time.January, 1, 1, 0, 0, 0, time.FixedZone("", 36000)),
+		},
+		{
+			name: "unix",
+			str:  "@1640998800",
+			want: time.Unix(1640998800, 0),
+		},
+		{
+			name: "invalid_unix",
+			str:  "@invalid",
+			err:  "invalid date: @invalid",
+		},
+		{
+			name: "invalid",
+			str:  "invalid",
+			err:  "invalid date: invalid",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			d, err := commit.ParseDate(tt.str)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.True(t, tt.want.Equal(d), "want %v, got %v", tt.want, d)
+		})
+	}
+}
@@ -0,0 +1,204 @@
+package commit
+
+import (
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/repository"
+)
+
+// Normalised is a commit with the emoji of the subject in the other form and
+// the message with the emoji converted. Skipped commits are not rewritten as
+// they have been pushed.
+type Normalised struct {
+	Commit  repository.Head
+	Message string
+	Skipped bool
+}
+
+// NormaliseRequest finds the commits of the revision range to normalise. An
+// empty revision is the commits not yet pushed to a remote. Rewriting rewords
+// the commits by rebasing the current branch and needs an upstream when no
+// revision is given.
+type NormaliseRequest struct {
+	Revision  string
+	Emojis    *emoji.Set
+	EmojiType config.EmojiType
+	Author    repository.User
+	Rewrite   bool
+	Clean     bool
+	Upstream  bool
+	DryRun    bool
+	Output    io.Writer
+}
+
+// NewNormaliseRequest creates a request normalising the emojis to the form of
+// the config.
+func NewNormaliseRequest(state *State, rev string, rewrite bool) *NormaliseRequest {
+	author, _ := requestAuthor(state, "")
+
+	return &NormaliseRequest{
+		Revision:  rev,
+		Emojis:    state.Emojis,
+		EmojiType: state.Config.Commit.EmojiType,
+		Author:    author,
+		Rewrite:   rewrite,
+		Clean:     state.Repository.Worktree.IsClean(),
+		Upstream:  state.Repository.Branch.Remote != "",
+		DryRun:    state.Options.DryRun,
+	}
+}
+
+// Normalise returns the commits of the revision range with an emoji not in
+// the form of the emoji type, newest first. Rewriting creates an amend commit
+// for each commit not yet pushed and squashes them into the commits with an
+// autosquash rebase. Pushed commits are skipped.
+func (c *Commit) Normalise(req *NormaliseRequest) ([]Normalised, error) {
+	hs, err := c.normaliseLog(req.Revision)
+	if err != nil {
+		return nil, fmt.Errorf("unable to get commits: %w", err)
+	}
+
+	ns := Normalise(req.Emojis, req.EmojiType, hs)
+
+	if !req.Rewrite || len(ns) == 0 {
+		return ns, nil
+	}
+
+	if !req.Clean {
+		return nil, fmt.Errorf("%w: %w", ErrReword, ErrDirty)
+	}
+
+	// Without an upstream the default range can not tell which commits the
+	// branch has published so the range must be given.
+	if req.Revision == "" && !req.Upstream {
+		return nil, fmt.Errorf("%w: %w", ErrReword, ErrUpstream)
+	}
+
+	if err := c.skipPushed(req.Revision, ns); err != nil {
+		return nil, fmt.Errorf("unable to get unpushed commits: %w", err)
+	}
+
+	var rewrite []Normalised
+
+	for _, n := range ns {
+		if !n.Skipped {
+			rewrite = append(rewrite, n)
+		}
+	}
+
+	if len(rewrite) == 0 {
+		return ns, nil
+	}
+
+	oldest := rewrite[len(rewrite)-1].Commit
+
+	if err := c.Repoer.CheckReword(oldest.Hash); err != nil {
+		return nil, fmt.Errorf("%w: %w", ErrReword, err)
+	}
+
+	if req.DryRun {
+		return ns, nil
+	}
+
+	for i := len(rewrite) - 1; i >= 0; i-- {
+		com := repository.Commit{
+			Author:     UserToAuthor(req.Author),
+			Subject:    fmt.Sprintf("%s! %s", FixupAmend, rewrite[i].Commit.Hash),
+			Body:       strings.TrimSpace(rewrite[i].Message),
+			AllowEmpty: true,
+			NoHooks:    true,
+			Output:     req.Output,
+		}
+
+		if err := c.Repoer.Apply(com); err != nil {
+			return nil, fmt.Errorf("%w: unable to apply commit: %w", ErrCommit, err)
+		}
+	}
+
+	if err := c.Repoer.Autosquash(oldest.Hash, req.Output); err != nil {
+		return nil, fmt.Errorf("%w: unable to reword commit: %w", ErrCommit, err)
+	}
+
+	return ns, nil
+}
+
+// normaliseLog returns the commits of the revision range. Without a range the
+// commits not yet pushed to a remote are returned.
+func (c *Commit) normaliseLog(rev string) ([]repository.Head, error) {
+	if rev == "" {
+		return c.Repoer.Unpushed()
+	}
+
+	return c.Repoer.Log(rev)
+}
+
+// skipPushed marks the commits that are on a remote-tracking branch as
+// skipped. The commits of the default range are never pushed.
+func (c *Commit) skipPushed(rev string, ns []Normalised) error {
+	if rev == "" {
+		return nil
+	}
+
+	hs, err := c.Repoer.Unpushed()
+	if err != nil {
+		return err
+	}
+
+	unpushed := make(map[string]bool, len(hs))
+	for _, h := range hs {
+		unpushed[h.Hash] = true
+	}
+
+	for i := range ns {
+		ns[i].Skipped = !unpushed[ns[i].Commit.Hash]
+	}
+
+	return nil
+}
+
+// Normalise returns the commits with an emoji not in the form of the emoji
+// type. Emojis missing from the set are left unchanged.
+func Normalise(set *emoji.Set, et config.EmojiType, hs []repository.Head) []Normalised {
+	var ns []Normalised
+
+	for _, h := range hs {
+		if msg := NormaliseMessage(set, et, h.Message); msg != h.Message {
+			ns = append(ns, Normalised{Commit: h, Message: msg})
+		}
+	}
+
+	return ns
+}
+
+// NormaliseMessage returns the message with the emoji starting the subject in
+// the form of the emoji type.
+func NormaliseMessage(set *emoji.Set, et config.EmojiType, msg string) string {
+	fw, rest, found := strings.Cut(msg, " ")
+	if strings.Contains(fw, "\n") {
+		return msg
+	}
+
+	e := set.Find(fw)
+	if !e.Valid {
+		return msg
+	}
+
+	var form string
+
+	switch et {
+	case config.EmojiTypeShortcode:
+		form = e.Emoji.Shortcode
+	default:
+		form = e.Emoji.Character
+	}
+
+	if !found {
+		return form
+	}
+
+	return form + " " + rest
+}
@@ -0,0 +1,470 @@
+package commit_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNormaliseMessage(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		emojiType config.EmojiType
+		msg       string
+		want      string
+	}{
+		{
+			name:      "shortcode_to_character",
+			emojiType: config.EmojiTypeCharacter,
+			msg:       ":bug: Fix the parser\n\nBody\n",
+			want:      "🐛 Fix the parser\n\nBody\n",
+		},
+		{
+			name:      "character_to_shortcode",
+			emojiType: config.EmojiTypeShortcode,
+			msg:       "🐛 Fix the parser\n",
+			want:      ":bug: Fix the parser\n",
+		},
+		{
+			name: "unset",
+			msg:  ":bug: Fix the parser\n",
+			want: "🐛 Fix the parser\n",
+		},
+		{
+			name:      "consistent",
+			emojiType: config.EmojiTypeCharacter,
+			msg:       "🐛 Fix the parser\n",
+			want:      "🐛 Fix the parser\n",
+		},
+		{
+			name:      "emoji_only",
+			emojiType: config.EmojiTypeCharacter,
+			msg:       ":bug:",
+			want:      "🐛",
+		},
+		{
+			name:      "no_emoji",
+			emojiType: config.EmojiTypeCharacter,
+			msg:       "Fix the parser\n",
+			want:      "Fix the parser\n",
+		},
+		{
+			name:      "unknown_emoji",
+			emojiType: config.EmojiTypeCharacter,
+			msg:       ":unknown: Fix the parser\n",
+			want:      ":unknown: Fix the parser\n",
+		},
+		{
+			name:      "body_emoji",
+			emojiType: config.EmojiTypeCharacter,
+			msg:       "Fix\n:bug: the parser\n",
+			want:      "Fix\n:bug: the parser\n",
+		},
+		{
+			name:      "empty",
+			emojiType: config.EmojiTypeCharacter,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := commit.NormaliseMessage(emoji.New(), tt.emojiType, tt.msg)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestNormalise(t *testing.T) {
+	t.Parallel()
+
+	log := []repository.Head{
+		{Hash: "3", Message: ":bug: Fix the parser\n"},
+		{Hash: "2", Message: "🎨 Split the model\n"},
+		{Hash: "1", Message: ":art: Add the model\n\nBody\n"},
+	}
+
+	author := repository.User{
+		Name:  "John Doe",
+		Email: "john.doe@example.com",
+	}
+
+	type args struct {
+		req         commit.NormaliseRequest
+		log         []repository.Head
+		unpushed    []repository.Head
+		logErr      error
+		unpushedErr error
+		applyErr    error
+		rewordErr   error
+		squashErr   error
+	}
+
+	type want struct {
+		hashes  []string
+		skipped []string
+		coms    []repository.Commit
+		squash  string
+		err     string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "report",
+			args: args{
+				req: commit.NormaliseRequest{Revision: "HEAD"},
+			},
+			want: want{
+				hashes: []string{"3", "1"},
+			},
+		},
+		{
+			name: "report_shortcode",
+			args: args{
+				req: commit.NormaliseRequest{
+					Revision:  "HEAD",
+					EmojiType: config.EmojiTypeShortcode,
+				},
+			},
+			want: want{
+				hashes: []string{"2"},
+			},
+		},
+		{
+			name: "report_unpushed",
+			args: args{
+				unpushed: log[:1],
+			},
+			want: want{
+				hashes: []string{"3"},
+			},
+		},
+		{
+			name: "rewrite",
+			args: args{
+				req: commit.NormaliseRequest{
+					Revision: "HEAD",
+					Author:   author,
+					Rewrite:  true,
+					Clean:    true,
+				},
+			},
+			want: want{
+				hashes: []string{"3", "1"},
+				coms: []repository.Commit{
+					{
+						Author:     "John Doe <john.doe@example.com>",
+						Subject:    "amend! 1",
+						Body:       "🎨 Add the model\n\nBody",
+						AllowEmpty: true,
+						NoHooks:    true,
+					},
+					{
+						Author:     "John Doe <john.doe@example.com>",
+						Subject:    "amend! 3",
+						Body:       "🐛 Fix the parser",
+						AllowEmpty: true,
+						NoHooks:    true,
+					},
+				},
+				squash: "1",
+			},
+		},
+		{
+			name: "rewrite_unpushed",
+			args: args{
+				req: commit.NormaliseRequest{
+					Author:   author,
+					Rewrite:  true,
+					Clean:    true,
+					Upstream: true,
+				},
+				unpushed: log[:2],
+			},
+			want: want{
+				hashes: []string{"3"},
+				coms: []repository.Commit{
+					{
+						Author:     "John Doe <john.doe@example.com>",
+						Subject:    "amend! 3",
+						Body:       "🐛 Fix the parser",
+						AllowEmpty: true,
+						NoHooks:    true,
+					},
+				},
+				squash: "3",
+			},
+		},
+		{
+			name: "rewrite_no_upstream",
+			args: args{
+				req: commit.NormaliseRequest{
+					Author:  author,
+					Rewrite: true,
+					Clean:   true,
+				},
+				unpushed: log[:2],
+			},
+			want: want{
+				err: "invalid reword: branch has no upstream",
+			},
+		},
+		{
+			name: "rewrite_pushed",
+			args: args{
+				req: commit.NormaliseRequest{
+					Revision: "HEAD",
+					Author:   author,
+					Rewrite:  true,
+					Clean:    true,
+				},
+				unpushed: log[:1],
+			},
+			want: want{
+				hashes:  []string{"3", "1"},
+				skipped: []string{"1"},
+				coms: []repository.Commit{
+					{
+						Author:     "John Doe <john.doe@example.com>",
+						Subject:    "amend! 3",
+						Body:       "🐛 Fix the parser",
+						AllowEmpty: true,
+						NoHooks:    true,
+					},
+				},
+				squash: "3",
+			},
+		},
+		{
+			name: "rewrite_all_pushed",
+			args: args{
+				req: commit.NormaliseRequest{
+					Revision: "HEAD",
+					Rewrite:  true,
+					Clean:    true,
+				},
+				unpushed: []repository.Head{},
+			},
+			want: want{
+				hashes:  []string{"3", "1"},
+				skipped: []string{"3", "1"},
+			},
+		},
+		{
+			name: "rewrite_consistent",
+			args: args{
+				req: commit.NormaliseRequest{
+					Revision: "HEAD",
+					Rewrite:  true,
+				},
+				log: []repository.Head{
+					{Hash: "1", Message: "🐛 Fix the parser\n"},
+				},
+			},
+		},
+		{
+			name: "rewrite_dry_run",
+			args: args{
+				req: commit.NormaliseRequest{
+					Revision: "HEAD",
+					Rewrite:  true,
+					Clean:    true,
+					DryRun:   true,
+				},
+				unpushed: log[:1],
+			},
+			want: want{
+				hashes:  []string{"3", "1"},
+				skipped: []string{"1"},
+			},
+		},
+		{
+			name: "rewrite_dirty",
+			args: args{
+				req: commit.NormaliseRequest{
+					Revision: "HEAD",
+					Rewrite:  true,
+				},
+			},
+			want: want{
+				err: "invalid reword: worktree has changes",
+			},
+		},
+		{
+			name: "rewrite_unsafe",
+			args: args{
+				req: commit.NormaliseRequest{
+					Revision: "HEAD",
+					Rewrite:  true,
+					Clean:    true,
+				},
+				rewordErr: repository.ErrRewordMerge,
+			},
+			want: want{
+				err: "invalid reword: merge commits would be rebased",
+			},
+		},
+		{
+			name: "rewrite_apply_error",
+			args: args{
+				req: commit.NormaliseRequest{
+					Revision: "HEAD",
+					Rewrite:  true,
+					Clean:    true,
+				},
+				applyErr: errMock,
+			},
+			want: want{
+				err: "commit failed: unable to apply commit: error",
+			},
+		},
+		{
+			name: "rewrite_squash_error",
+			args: args{
+				req: commit.NormaliseRequest{
+					Revision: "HEAD",
+					Rewrite:  true,
+					Clean:    true,
+				},
+				squashErr: errMock,
+			},
+			want: want{
+				err: "commit failed: unable to reword commit: error",
+			},
+		},
+		{
+			name: "rewrite_unpushed_error",
+			args: args{
+				req: commit.NormaliseRequest{
+					Revision: "HEAD",
+					Rewrite:  true,
+					Clean:    true,
+				},
+				unpushedErr: errMock,
+			},
+			want: want{
+				err: "unable to get unpushed commits: error",
+			},
+		},
+		{
+			name: "log_error",
+			args: args{
+				req:    commit.NormaliseRequest{Revision: "HEAD"},
+				logErr: errMock,
+			},
+			want: want{
+				err: "unable to get commits: error",
+			},
+		},
+		{
+			name: "unpushed_error",
+			args: args{
+				unpushedErr: errMock,
+			},
+			want: want{
+				err: "unable to get commits: error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			repo := MockRepository{
+				log:       log,
+				unpush:    log,
+				logErr:    tt.args.logErr,
+				unpushErr: tt.args.unpushedErr,
+				applyErr:  tt.args.applyErr,
+				rewordErr: tt.args.rewordErr,
+				squashErr: tt.args.squashErr,
+			}
+
+			if tt.args.log != nil {
+				repo.log = tt.args.log
+			}
+
+			if tt.args.unpushed != nil {
+				repo.unpush = tt.args.unpushed
+			}
+
+			c := commit.Commit{
+				Repoer: &repo,
+			}
+
+			req := tt.args.req
+			req.Emojis = emoji.New()
+
+			ns, err := c.Normalise(&req)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			var hashes, skipped []string
+			for _, n := range ns {
+				hashes = append(hashes, n.Commit.Hash)
+
+				if n.Skipped {
+					skipped = append(skipped, n.Commit.Hash)
+				}
+			}
+
+			assert.Equal(t, tt.want.hashes, hashes)
+			assert.Equal(t, tt.want.skipped, skipped)
+			assert.Equal(t, tt.want.coms, repo.coms)
+			assert.Equal(t, tt.want.squash, repo.squash)
+		})
+	}
+}
+
+func TestNewNormaliseRequest(t *testing.T) {
+	t.Parallel()
+
+	set := emoji.New()
+
+	state := &commit.State{
+		Emojis: set,
+		Config: config.Config{
+			Commit: config.Commit{EmojiType: config.EmojiTypeShortcode},
+		},
+		Repository: repository.Description{
+			Users: []repository.User{
+				{Name: "John Doe", Email: "john.doe@example.com", Default: true},
+			},
+			Branch: repository.Branch{Local: "master", Remote: "origin/master"},
+		},
+		Options: commit.Options{DryRun: true},
+	}
+
+	want := &commit.NormaliseRequest{
+		Revision:  "HEAD~2..",
+		Emojis:    set,
+		EmojiType: config.EmojiTypeShortcode,
+		Author:    repository.User{Name: "John Doe", Email: "john.doe@example.com", Default: true},
+		Rewrite:   true,
+		Clean:     true,
+		Upstream:  true,
+		DryRun:    true,
+	}
+
+	assert.Equal(t, want, commit.NewNormaliseRequest(state, "HEAD~2..", true))
+}
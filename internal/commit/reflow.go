@@ -0,0 +1,155 @@
+package commit
+
+import (
+	"regexp"
+	"strings"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// ReflowWidth is the recommended width of the commit body.
+const ReflowWidth = 72
+
+type paragraph struct {
+	prefix string
+	indent string
+	words  []string
+	list   bool
+}
+
+var (
+	listPattern    = regexp.MustCompile(`^(\s*)([-*+]|\d+[.)])\s+(.*)$`)
+	fencePattern   = regexp.MustCompile("^\\s*(```|~~~)")
+	trailerPattern = regexp.MustCompile(`^[A-Za-z0-9][A-Za-z0-9-]*:\s`)
+)
+
+// Reflow rewraps paragraphs of the body to the width. Bullet lists are
+// wrapped with a hanging indent while code blocks, quotes, comments and
+// trailers are left unchanged. Words longer than the width such as URLs are
+// never broken.
+func Reflow(str string, width int) string {
+	lines := strings.Split(str, "\n")
+	trailers := trailerStart(lines)
+
+	var out []string
+	var para *paragraph
+	var fence bool
+
+	flush := func() {
+		if para == nil {
+			return
+		}
+
+		out = append(out, para.wrap(width)...)
+		para = nil
+	}
+
+	for i, line := range lines {
+		indented := strings.HasPrefix(line, "\t") || strings.HasPrefix(line, "    ")
+		trimmed := strings.TrimSpace(line)
+
+		switch {
+		case fence:
+			out = append(out, line)
+			fence = !fencePattern.MatchString(line)
+		case fencePattern.MatchString(line):
+			flush()
+			out = append(out, line)
+			fence = true
+		case trimmed == "":
+			flush()
+			out = append(out, "")
+		case i >= trailers, strings.HasPrefix(trimmed, ">"), strings.HasPrefix(trimmed, "#"):
+			flush()
+			out = append(out, line)
+		case listPattern.MatchString(line):
+			flush()
+			para = newListParagraph(line)
+		case para != nil && para.list:
+			para.words = append(para.words, strings.Fields(line)...)
+		case indented:
+			flush()
+			out = append(out, line)
+		case para != nil:
+			para.words = append(para.words, strings.Fields(line)...)
+		default:
+			indent := line[:len(line)-len(strings.TrimLeft(line, " "))]
+			para = &paragraph{
+				prefix: indent,
+				indent: indent,
+				words:  strings.Fields(line),
+			}
+		}
+	}
+
+	flush()
+
+	return strings.Join(out, "\n")
+}
+
+func newListParagraph(line string) *paragraph {
+	m := listPattern.FindStringSubmatch(line)
+	prefix := m[1] + m[2] + " "
+
+	return &paragraph{
+		prefix: prefix,
+		indent: strings.Repeat(" ", ansi.StringWidth(prefix)),
+		words:  strings.Fields(m[3]),
+		list:   true,
+	}
+}
+
+func (p paragraph) wrap(width int) []string {
+	var lines []string
+
+	var sb strings.Builder
+	sb.WriteString(p.prefix)
+
+	empty := true
+
+	for _, w := range p.words {
+		if !empty && ansi.StringWidth(sb.String())+1+ansi.StringWidth(w) > width {
+			lines = append(lines, sb.String())
+			sb.Reset()
+			sb.WriteString(p.indent)
+
+			empty = true
+		}
+
+		if !empty {
+			sb.WriteString(" ")
+		}
+
+		sb.WriteString(w)
+
+		empty = false
+	}
+
+	return append(lines, strings.TrimRight(sb.String(), " "))
+}
+
+// trailerStart returns the first line of the trailers which are the last
+// paragraph when every line is a key value pair.
+func trailerStart(lines []string) int {
+	end := len(lines)
+	for end > 0 && strings.TrimSpace(lines[end-1]) == "" {
+		end--
+	}
+
+	start := end
+	for start > 0 && strings.TrimSpace(lines[start-1]) != "" {
+		start--
+	}
+
+	if start == end {
+		return len(lines)
+	}
+
+	for _, l := range lines[start:end] {
+		if !trailerPattern.MatchString(l) {
+			return len(lines)
+		}
+	}
+
+	return start
+}
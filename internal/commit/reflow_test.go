@@ -0,0 +1,44 @@
+package commit_test
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+
+	"github.com/hexops/autogold/v2"
+)
+
+func TestReflow(t *testing.T) {
+	t.Parallel()
+
+	dir := filepath.Join("testdata", "reflow")
+
+	files, err := filepath.Glob(filepath.Join(dir, "*.input"))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, file := range files {
+		file := file
+
+		_, filename := filepath.Split(file)
+		ext := filepath.Ext(file)
+		testLen := len(filename) - len(ext)
+		testName := filename[:testLen]
+
+		t.Run(testName, func(t *testing.T) {
+			t.Parallel()
+
+			source, err := os.ReadFile(file)
+			if err != nil {
+				t.Fatal("unable to read source file:", err)
+			}
+
+			got := commit.Reflow(string(source), commit.ReflowWidth)
+
+			autogold.ExpectFile(t, autogold.Raw(got), autogold.Name(testName), autogold.Dir(dir))
+		})
+	}
+}
@@ -0,0 +1,190 @@
+package commit
+
+import (
+	"errors"
+	"fmt"
+	"net/mail"
+	"strings"
+	"unicode/utf8"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Message is a commit message provided without the user interface.
+type Message struct {
+	Emoji   string
+	Summary string
+	Body    string
+	Author  string
+	Signoff bool
+	Amend   bool
+}
+
+// SummaryLimit is the maximum length of the summary.
+const SummaryLimit = 72
+
+// bodyWidth matches the wrap width of the body in the user interface.
+const bodyWidth = ReflowWidth - 1
+
+var (
+	ErrEmoji   = errors.New("emoji not found")
+	ErrSummary = errors.New("invalid summary")
+	ErrAuthor  = errors.New("invalid author")
+	ErrStaged  = errors.New("no changes added to commit")
+)
+
+// NewRequest creates a request from a message with the same validation and
+// formatting as the user interface.
+func NewRequest(state *State, msg Message) (*Request, error) {
+	summary := strings.TrimSpace(msg.Summary)
+
+	switch {
+	case summary == "":
+		return nil, fmt.Errorf("%w: summary is empty", ErrSummary)
+	case strings.Contains(summary, "\n"):
+		return nil, fmt.Errorf("%w: summary has multiple lines", ErrSummary)
+	case utf8.RuneCountInString(summary) > SummaryLimit:
+		return nil, fmt.Errorf("%w: summary is longer than %v characters", ErrSummary, SummaryLimit)
+	}
+
+	if !state.Repository.Worktree.IsStaged() && !msg.Amend {
+		return nil, ErrStaged
+	}
+
+	var emoji string
+
+	if msg.Emoji != "" {
+		e := state.Emojis.Find(msg.Emoji)
+		if !e.Valid {
+			return nil, fmt.Errorf("%w: %v", ErrEmoji, msg.Emoji)
+		}
+
+		switch state.Config.Commit.EmojiType {
+		case config.EmojiTypeShortcode:
+			emoji = e.Emoji.Shortcode
+		default:
+			emoji = e.Emoji.Character
+		}
+	}
+
+	author, err := requestAuthor(state, msg.Author)
+	if err != nil {
+		return nil, err
+	}
+
+	var footer string
+	if msg.Signoff {
+		footer = Signoff(author)
+	}
+
+	return &Request{
+		Apply:         true,
+		Emoji:         emoji,
+		Summary:       summary,
+		Body:          FormatBody(msg.Body, bodyWidth, state.Config.Commit.Reflow),
+		RawBody:       msg.Body,
+		Footer:        footer,
+		Author:        author,
+		AuthorDate:    state.Identity.AuthorDate,
+		Committer:     state.Identity.Committer,
+		CommitterDate: state.Identity.CommitterDate,
+		Amend:         msg.Amend,
+		DryRun:        state.Options.DryRun,
+		Headless:      true,
+	}, nil
+}
+
+// NewFileRequest creates a request from the message file with the formatting
+// of the config. No request is created when the message has no summary.
+func NewFileRequest(state *State) *Request {
+	msg := state.File.Message
+
+	summary := strings.TrimSpace(MessageToSummary(msg))
+	if summary == "" {
+		return nil
+	}
+
+	var emoji string
+
+	if e := MessageToEmoji(state.Emojis, msg); e.Valid {
+		switch state.Config.Commit.EmojiType {
+		case config.EmojiTypeShortcode:
+			emoji = e.Emoji.Shortcode
+		default:
+			emoji = e.Emoji.Character
+		}
+	}
+
+	author, _ := requestAuthor(state, "")
+	body := MessageToBody(msg)
+
+	var footer string
+	if state.Config.Commit.Signoff && !strings.Contains(body, Signoff(author)) {
+		footer = Signoff(author)
+	}
+
+	return &Request{
+		Apply:       true,
+		Emoji:       emoji,
+		Summary:     summary,
+		Body:        FormatBody(body, bodyWidth, state.Config.Commit.Reflow),
+		RawBody:     body,
+		Footer:      footer,
+		Author:      author,
+		Amend:       state.File.Amend,
+		DryRun:      state.Options.DryRun,
+		File:        true,
+		MessageFile: state.Options.File.MessageFile,
+		Comments:    state.File.Comments,
+		Headless:    true,
+	}
+}
+
+// FormatBody wraps the body to the width. Reflow rewraps paragraphs rather
+// than only breaking long lines.
+func FormatBody(str string, width int, reflow bool) string {
+	switch {
+	case reflow:
+		str = Reflow(str, width)
+	default:
+		str = ansi.Wordwrap(str, width, "")
+	}
+
+	return strings.TrimSpace(str)
+}
+
+// Signoff returns the sign off trailer of the user.
+func Signoff(user repository.User) string {
+	return fmt.Sprintf("Signed-off-by: %s <%s>", user.Name, user.Email)
+}
+
+// AuthorToUser parses an author in the format "Name <email>".
+func AuthorToUser(str string) (repository.User, error) {
+	addr, err := mail.ParseAddress(str)
+	if err != nil || addr.Name == "" {
+		return repository.User{}, fmt.Errorf("%w: %v", ErrAuthor, str)
+	}
+
+	return repository.User{
+		Name:  addr.Name,
+		Email: addr.Address,
+	}, nil
+}
+
+// requestAuthor returns the author or the default author of the repository
+// when empty.
+func requestAuthor(state *State, str string) (repository.User, error) {
+	if str != "" {
+		return AuthorToUser(str)
+	}
+
+	authors := Authors(state)
+	if len(authors) == 0 {
+		return repository.User{}, nil
+	}
+
+	return authors[0], nil
+}
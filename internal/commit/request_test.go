@@ -0,0 +1,491 @@
+package commit_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNewRequest(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		state func(s *commit.State)
+		msg   commit.Message
+	}
+
+	type want struct {
+		req *commit.Request
+		err string
+	}
+
+	user := repository.User{Name: "John Doe", Email: "john.doe@example.com", Default: true}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "summary",
+			args: args{
+				msg: commit.Message{Summary: "summary"},
+			},
+			want: want{
+				req: &commit.Request{
+					Apply:    true,
+					Summary:  "summary",
+					Author:   user,
+					Headless: true,
+				},
+			},
+		},
+		{
+			name: "author_rule",
+			args: args{
+				state: func(s *commit.State) {
+					s.AuthorRule = &config.AuthorRule{
+						Author: repository.User{Name: "John Doe", Email: "john.doe@acme.com"},
+					}
+				},
+				msg: commit.Message{Summary: "summary"},
+			},
+			want: want{
+				req: &commit.Request{
+					Apply:    true,
+					Summary:  "summary",
+					Author:   repository.User{Name: "John Doe", Email: "john.doe@acme.com"},
+					Headless: true,
+				},
+			},
+		},
+		{
+			name: "emoji_shortcode",
+			args: args{
+				msg: commit.Message{Emoji: ":bug:", Summary: "summary"},
+			},
+			want: want{
+				req: &commit.Request{
+					Apply:    true,
+					Emoji:    "🐛",
+					Summary:  "summary",
+					Author:   user,
+					Headless: true,
+				},
+			},
+		},
+		{
+			name: "emoji_type_shortcode",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.Commit.EmojiType = config.EmojiTypeShortcode
+				},
+				msg: commit.Message{Emoji: "🐛", Summary: "summary"},
+			},
+			want: want{
+				req: &commit.Request{
+					Apply:    true,
+					Emoji:    ":bug:",
+					Summary:  "summary",
+					Author:   user,
+					Headless: true,
+				},
+			},
+		},
+		{
+			name: "body",
+			args: args{
+				msg: commit.Message{Summary: "summary", Body: strings.Repeat("word ", 20) + "\n"},
+			},
+			want: want{
+				req: &commit.Request{
+					Apply:    true,
+					Summary:  "summary",
+					Body:     strings.TrimSpace(strings.Repeat("word ", 14)) + "\n" + strings.TrimSpace(strings.Repeat("word ", 6)),
+					RawBody:  strings.Repeat("word ", 20) + "\n",
+					Author:   user,
+					Headless: true,
+				},
+			},
+		},
+		{
+			name: "signoff",
+			args: args{
+				msg: commit.Message{Summary: "summary", Signoff: true},
+			},
+			want: want{
+				req: &commit.Request{
+					Apply:    true,
+					Summary:  "summary",
+					Footer:   "Signed-off-by: John Doe <john.doe@example.com>",
+					Author:   user,
+					Headless: true,
+				},
+			},
+		},
+		{
+			name: "author",
+			args: args{
+				msg: commit.Message{Summary: "summary", Author: "Jane Doe <jane.doe@example.com>", Signoff: true},
+			},
+			want: want{
+				req: &commit.Request{
+					Apply:    true,
+					Summary:  "summary",
+					Footer:   "Signed-off-by: Jane Doe <jane.doe@example.com>",
+					Author:   repository.User{Name: "Jane Doe", Email: "jane.doe@example.com"},
+					Headless: true,
+				},
+			},
+		},
+		{
+			name: "amend",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.Worktree.Status = nil
+				},
+				msg: commit.Message{Summary: "summary", Amend: true},
+			},
+			want: want{
+				req: &commit.Request{
+					Apply:    true,
+					Summary:  "summary",
+					Author:   user,
+					Amend:    true,
+					Headless: true,
+				},
+			},
+		},
+		{
+			name: "dry_run",
+			args: args{
+				state: func(s *commit.State) {
+					s.Options.DryRun = true
+				},
+				msg: commit.Message{Summary: "summary"},
+			},
+			want: want{
+				req: &commit.Request{
+					Apply:    true,
+					Summary:  "summary",
+					Author:   user,
+					DryRun:   true,
+					Headless: true,
+				},
+			},
+		},
+		{
+			name: "identity",
+			args: args{
+				state: func(s *commit.State) {
+					s.Identity = commit.Identity{
+						AuthorDate:    time.Date(2022, time.January, 1, 1, 0, 0, 0, time.UTC),
+						Committer:     repository.User{Name: "Jane Doe", Email: "jane.doe@example.com"},
+						CommitterDate: time.Date(2022, time.January, 2, 1, 0, 0, 0, time.UTC),
+					}
+				},
+				msg: commit.Message{Summary: "summary"},
+			},
+			want: want{
+				req: &commit.Request{
+					Apply:         true,
+					Summary:       "summary",
+					Author:        user,
+					AuthorDate:    time.Date(2022, time.January, 1, 1, 0, 0, 0, time.UTC),
+					Committer:     repository.User{Name: "Jane Doe", Email: "jane.doe@example.com"},
+					CommitterDate: time.Date(2022, time.January, 2, 1, 0, 0, 0, time.UTC),
+					Headless:      true,
+				},
+			},
+		},
+		{
+			name: "empty_summary",
+			args: args{
+				msg: commit.Message{Summary: " "},
+			},
+			want: want{
+				err: "invalid summary: summary is empty",
+			},
+		},
+		{
+			name: "multiline_summary",
+			args: args{
+				msg: commit.Message{Summary: "summary\nsummary"},
+			},
+			want: want{
+				err: "invalid summary: summary has multiple lines",
+			},
+		},
+		{
+			name: "long_summary",
+			args: args{
+				msg: commit.Message{Summary: strings.Repeat("a", 73)},
+			},
+			want: want{
+				err: "invalid summary: summary is longer than 72 characters",
+			},
+		},
+		{
+			name: "not_staged",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.Worktree.Status = nil
+				},
+				msg: commit.Message{Summary: "summary"},
+			},
+			want: want{
+				err: "no changes added to commit",
+			},
+		},
+		{
+			name: "invalid_emoji",
+			args: args{
+				msg: commit.Message{Emoji: ":invalid:", Summary: "summary"},
+			},
+			want: want{
+				err: "emoji not found: :invalid:",
+			},
+		},
+		{
+			name: "invalid_author",
+			args: args{
+				msg: commit.Message{Summary: "summary", Author: "john.doe@example.com"},
+			},
+			want: want{
+				err: "invalid author: john.doe@example.com",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := commit.State{
+				Emojis: emoji.New(),
+				Repository: repository.Description{
+					Users: []repository.User{user},
+					Worktree: repository.Worktree{
+						Status: git.Status{
+							"main.go": &git.FileStatus{Staging: git.Modified},
+						},
+					},
+				},
+			}
+
+			if tt.args.state != nil {
+				tt.args.state(&state)
+			}
+
+			req, err := commit.NewRequest(&state, tt.args.msg)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.req, req)
+		})
+	}
+}
+
+func TestNewFileRequest(t *testing.T) {
+	t.Parallel()
+
+	user := repository.User{Name: "John Doe", Email: "john.doe@example.com", Default: true}
+	comments := "# Please enter the commit message for your changes.\n#\n# On branch master"
+
+	tests := []struct {
+		name    string
+		message string
+		state   func(s *commit.State)
+		req     *commit.Request
+	}{
+		{
+			name:    "empty",
+			message: "",
+		},
+		{
+			name:    "comments",
+			message: "\n" + comments,
+		},
+		{
+			name:    "summary",
+			message: "summary\n" + comments,
+			req: &commit.Request{
+				Apply:       true,
+				Summary:     "summary",
+				Author:      user,
+				File:        true,
+				MessageFile: "COMMIT_EDITMSG",
+				Comments:    comments,
+				Headless:    true,
+			},
+		},
+		{
+			name:    "emoji_body",
+			message: ":bug: summary\n\nbody\n" + comments,
+			req: &commit.Request{
+				Apply:       true,
+				Emoji:       "🐛",
+				Summary:     "summary",
+				Body:        "body",
+				RawBody:     "body",
+				Author:      user,
+				File:        true,
+				MessageFile: "COMMIT_EDITMSG",
+				Comments:    comments,
+				Headless:    true,
+			},
+		},
+		{
+			name:    "emoji_type_shortcode",
+			message: "🐛 summary\n",
+			state: func(s *commit.State) {
+				s.Config.Commit.EmojiType = config.EmojiTypeShortcode
+			},
+			req: &commit.Request{
+				Apply:       true,
+				Emoji:       ":bug:",
+				Summary:     "summary",
+				Author:      user,
+				File:        true,
+				MessageFile: "COMMIT_EDITMSG",
+				Headless:    true,
+			},
+		},
+		{
+			name:    "signoff",
+			message: "summary\n",
+			state: func(s *commit.State) {
+				s.Config.Commit.Signoff = true
+			},
+			req: &commit.Request{
+				Apply:       true,
+				Summary:     "summary",
+				Footer:      "Signed-off-by: John Doe <john.doe@example.com>",
+				Author:      user,
+				File:        true,
+				MessageFile: "COMMIT_EDITMSG",
+				Headless:    true,
+			},
+		},
+		{
+			name:    "signoff_existing",
+			message: "summary\n\nSigned-off-by: John Doe <john.doe@example.com>\n",
+			state: func(s *commit.State) {
+				s.Config.Commit.Signoff = true
+			},
+			req: &commit.Request{
+				Apply:       true,
+				Summary:     "summary",
+				Body:        "Signed-off-by: John Doe <john.doe@example.com>",
+				RawBody:     "Signed-off-by: John Doe <john.doe@example.com>",
+				Author:      user,
+				File:        true,
+				MessageFile: "COMMIT_EDITMSG",
+				Headless:    true,
+			},
+		},
+		{
+			name:    "amend",
+			message: "summary\n",
+			state: func(s *commit.State) {
+				s.File.Amend = true
+			},
+			req: &commit.Request{
+				Apply:       true,
+				Summary:     "summary",
+				Author:      user,
+				Amend:       true,
+				File:        true,
+				MessageFile: "COMMIT_EDITMSG",
+				Headless:    true,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := commit.State{
+				Emojis: emoji.New(),
+				Repository: repository.Description{
+					Users: []repository.User{user},
+				},
+				File: commit.File{},
+				Options: commit.Options{
+					File: commit.FileOptions{MessageFile: "COMMIT_EDITMSG"},
+				},
+			}
+
+			state.File.Message, state.File.Comments = commit.SplitComments(tt.message, "#", commit.CleanupDefault)
+
+			if tt.state != nil {
+				tt.state(&state)
+			}
+
+			assert.Equal(t, tt.req, commit.NewFileRequest(&state))
+		})
+	}
+}
+
+func TestAuthorToUser(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  repository.User
+		err   string
+	}{
+		{
+			name:  "valid",
+			input: "John Doe <john.doe@example.com>",
+			want:  repository.User{Name: "John Doe", Email: "john.doe@example.com"},
+		},
+		{
+			name:  "quoted",
+			input: "\"Doe, John\" <john.doe@example.com>",
+			want:  repository.User{Name: "Doe, John", Email: "john.doe@example.com"},
+		},
+		{
+			name:  "email",
+			input: "john.doe@example.com",
+			err:   "invalid author: john.doe@example.com",
+		},
+		{
+			name:  "name",
+			input: "John Doe",
+			err:   "invalid author: John Doe",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := commit.AuthorToUser(tt.input)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,52 @@
+package commit
+
+import (
+	"strings"
+)
+
+// Source of the message given to the prepare commit message hook.
+type Source int
+
+const (
+	SourceUnset Source = iota
+	SourceMessage
+	SourceTemplate
+	SourceMerge
+	SourceSquash
+	SourceCommit
+)
+
+func ParseSource(str string) Source {
+	source := map[string]Source{
+		"":         SourceUnset,
+		"message":  SourceMessage,
+		"template": SourceTemplate,
+		"merge":    SourceMerge,
+		"squash":   SourceSquash,
+		"commit":   SourceCommit,
+	}
+
+	return source[strings.ToLower(str)]
+}
+
+func (s Source) String() string {
+	return []string{
+		"",
+		"message",
+		"template",
+		"merge",
+		"squash",
+		"commit",
+	}[s]
+}
+
+// Generated reports whether Git prepared the message, which takes precedence
+// over a restored snapshot.
+func (s Source) Generated() bool {
+	switch s {
+	case SourceTemplate, SourceMerge, SourceSquash, SourceCommit:
+		return true
+	}
+
+	return false
+}
@@ -0,0 +1,45 @@
+package commit_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestParseSource(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name      string
+		str       string
+		source    commit.Source
+		generated bool
+	}{
+		{name: "empty", str: "", source: commit.SourceUnset},
+		{name: "message", str: "message", source: commit.SourceMessage},
+		{name: "template", str: "template", source: commit.SourceTemplate, generated: true},
+		{name: "merge", str: "merge", source: commit.SourceMerge, generated: true},
+		{name: "squash", str: "squash", source: commit.SourceSquash, generated: true},
+		{name: "commit", str: "commit", source: commit.SourceCommit, generated: true},
+		{name: "uppercase", str: "MERGE", source: commit.SourceMerge, generated: true},
+		{name: "invalid", str: "invalid", source: commit.SourceUnset},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			s := commit.ParseSource(tt.str)
+			assert.Equal(t, tt.source, s)
+			assert.Equal(t, tt.generated, s.Generated())
+
+			if tt.source != commit.SourceUnset {
+				assert.Equal(t, tt.source, commit.ParseSource(s.String()))
+			}
+		})
+	}
+}
@@ -2,11 +2,16 @@ package commit
 
 import (
 	_ "embed"
+	"fmt"
+	"strings"
 
 	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/generator"
+	"github.com/mikelorant/committed/internal/keymap"
 	"github.com/mikelorant/committed/internal/repository"
 	"github.com/mikelorant/committed/internal/snapshot"
+	"github.com/mikelorant/committed/internal/spell"
 	"github.com/mikelorant/committed/internal/theme"
 )
 
@@ -16,9 +21,16 @@ type State struct {
 	Emojis       *emoji.Set
 	Theme        theme.Theme
 	Config       config.Config
+	KeyMap       keymap.KeyMap
 	Snapshot     snapshot.Snapshot
 	Options      Options
 	File         File
+	Identity     Identity
+	Reword       repository.Head
+	AuthorRule   *config.AuthorRule
+	Spelling     *spell.Checker
+	Generators   []generator.Generator
+	EmojiRules   []config.EmojiRule
 }
 
 type Placeholders struct {
@@ -34,27 +46,70 @@ type Config struct {
 	Authors []repository.User
 }
 
+// File is the message file. Comments is the comment block Git added to the
+// message. Commit is the commit the message was copied from when the source
+// is a commit other than the head.
 type File struct {
-	Amend   bool
-	Message string
+	Amend    bool
+	Message  string
+	Comments string
+	Source   Source
+	Commit   repository.Head
 }
 
 //go:embed message.txt
 var PlaceholderMessage string
 
-//go:embed help.txt
-var PlaceholderHelp string
-
 const (
 	PlaceholderHash    string = "1234567890abcdef1234567890abcdef12345678"
 	PlaceholderSummary string = "Capitalized, short (50 chars or less) summary"
 )
 
-func placeholders() Placeholders {
+var helpEmoji = [][]string{
+	{"Clear emoji", "delete"},
+	{"Reset filter", "escape"},
+	{"Next page", "page down"},
+	{"Previous page", "page up"},
+}
+
+func placeholders(km keymap.KeyMap) Placeholders {
 	return Placeholders{
 		Hash:    PlaceholderHash,
 		Summary: PlaceholderSummary,
 		Body:    PlaceholderMessage,
-		Help:    PlaceholderHelp,
+		Help:    PlaceholderHelp(km),
+	}
+}
+
+// PlaceholderHelp renders the help text with the keys of the key map.
+func PlaceholderHelp(km keymap.KeyMap) string {
+	var global [][]string
+
+	for _, b := range km.Bindings() {
+		if b.Key() == "" {
+			continue
+		}
+
+		global = append(global, []string{b.Description, b.Key()})
 	}
+
+	rows := []string{fmt.Sprintf("%-33s%s", "Global", "Emoji"), ""}
+
+	for i := range max(len(global), len(helpEmoji)) {
+		var row string
+
+		if i < len(global) {
+			row = fmt.Sprintf("%-21s%-12s", global[i][0], global[i][1])
+		} else {
+			row = strings.Repeat(" ", 33)
+		}
+
+		if i < len(helpEmoji) {
+			row += fmt.Sprintf("%-16s%s", helpEmoji[i][0], helpEmoji[i][1])
+		}
+
+		rows = append(rows, strings.TrimRight(row, " "))
+	}
+
+	return strings.Join(rows, "\n") + "\n"
 }
@@ -0,0 +1,57 @@
+package commit_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/keymap"
+
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestPlaceholderHelp(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		keys config.Keys
+	}{
+		{
+			name: "help_default",
+		},
+		{
+			name: "help_vim",
+			keys: config.Keys{Preset: config.KeyPresetVim},
+		},
+		{
+			name: "help_emacs",
+			keys: config.Keys{Preset: config.KeyPresetEmacs},
+		},
+		{
+			name: "help_unbound",
+			keys: config.Keys{
+				Bindings: map[string]config.KeyList{
+					"theme": {},
+					"write": {"alt+w"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			km, err := keymap.New(tt.keys)
+			assert.NoError(t, err)
+
+			got := commit.PlaceholderHelp(km)
+
+			autogold.ExpectFile(t, autogold.Raw(got), autogold.Name(tt.name))
+		})
+	}
+}
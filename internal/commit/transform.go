@@ -1,7 +1,6 @@
 package commit
 
 import (
-	"bufio"
 	"fmt"
 	"strings"
 
@@ -121,24 +120,3 @@ func concatSlice[T any](first []T, second []T) []T {
 	n := len(first)
 	return append(first[:n:n], second...)
 }
-
-func TrimComments(str string) string {
-	var sb strings.Builder
-
-	r := strings.NewReader(str)
-
-	scanner := bufio.NewScanner(r)
-
-	for scanner.Scan() {
-		txt := scanner.Text()
-
-		if strings.HasPrefix(txt, "#") && len(txt) > 70 {
-			fmt.Fprintln(&sb, txt[:70])
-			continue
-		}
-
-		fmt.Fprintln(&sb, txt)
-	}
-
-	return strings.TrimSpace(sb.String())
-}
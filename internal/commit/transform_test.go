@@ -1,15 +1,12 @@
 package commit_test
 
 import (
-	"os"
-	"path/filepath"
 	"testing"
 
 	"github.com/mikelorant/committed/internal/commit"
 	"github.com/mikelorant/committed/internal/emoji"
 	"github.com/mikelorant/committed/internal/repository"
 
-	"github.com/hexops/autogold/v2"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -533,37 +530,6 @@ func TestSortUsersByDefault(t *testing.T) {
 	}
 }
 
-func TestTrimComments(t *testing.T) {
-	t.Parallel()
-
-	files, err := filepath.Glob(filepath.Join("testdata", "*.input"))
-	if err != nil {
-		t.Fatal(err)
-	}
-
-	for _, file := range files {
-		file := file
-
-		_, filename := filepath.Split(file)
-		ext := filepath.Ext(file)
-		testLen := len(filename) - len(ext)
-		testName := filename[:testLen]
-
-		t.Run(testName, func(t *testing.T) {
-			t.Parallel()
-
-			source, err := os.ReadFile(file)
-			if err != nil {
-				t.Fatal("unable to read source file:", err)
-			}
-
-			got := commit.TrimComments(string(source))
-
-			autogold.ExpectFile(t, autogold.Raw(got), autogold.Name(testName))
-		})
-	}
-}
-
 func concatSlice[T any](first []T, second []T) []T {
 	n := len(first)
 	return append(first[:n:n], second...)
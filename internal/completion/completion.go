@@ -0,0 +1,202 @@
+package completion
+
+import (
+	"path"
+	"regexp"
+	"slices"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/mikelorant/committed/internal/fuzzy"
+	"github.com/mikelorant/committed/internal/repository"
+)
+
+type Item struct {
+	Name string
+}
+
+const (
+	minLength  = 3
+	minRank    = 3
+	maxMatches = 5
+)
+
+var identifierPattern = regexp.MustCompile(`[A-Za-z_][A-Za-z0-9_]*`)
+
+// Keywords that precede a declaration in common languages.
+var declarations = []string{
+	"class", "const", "def", "enum", "fn", "func", "function", "interface",
+	"let", "struct", "trait", "type", "var",
+}
+
+// Keywords that are followed by parentheses but are not calls.
+var keywords = []string{
+	"catch", "elif", "for", "func", "function", "if", "return", "sizeof",
+	"switch", "while",
+}
+
+func (i Item) Terms() []string {
+	return []string{i.Name}
+}
+
+// New returns the paths of the worktree followed by the identifiers of the
+// staged changes.
+func New(wt repository.Worktree) []Item {
+	paths := make([]string, 0, len(wt.Status))
+	for p := range wt.Status {
+		paths = append(paths, p)
+	}
+
+	sort.Strings(paths)
+
+	var is []Item
+
+	for _, n := range slices.Concat(paths, Identifiers(wt.Diff())) {
+		if !slices.Contains(is, Item{Name: n}) {
+			is = append(is, Item{Name: n})
+		}
+	}
+
+	return is
+}
+
+// Identifiers returns the declared, called and mixed case identifiers of the
+// changed lines of a diff in order of appearance.
+func Identifiers(diff string) []string {
+	var ids []string
+
+	for _, l := range strings.Split(diff, "\n") {
+		for _, id := range identifiers(changed(l)) {
+			if !slices.Contains(ids, id) {
+				ids = append(ids, id)
+			}
+		}
+	}
+
+	return ids
+}
+
+// Match returns the names of the items ranked for the term. Short terms match
+// the start of the name or the file name of a path.
+func Match(term string, items []Item) []string {
+	if term == "" {
+		return nil
+	}
+
+	var ms []string
+
+	switch {
+	case len([]rune(term)) < minRank:
+		for _, i := range items {
+			if hasPrefix(i.Name, term) || hasPrefix(path.Base(i.Name), term) {
+				ms = append(ms, i.Name)
+			}
+		}
+	default:
+		fis := make([]fuzzy.Item, len(items))
+		for i, item := range items {
+			fis[i] = item
+		}
+
+		for _, idx := range fuzzy.Rank(term, fis) {
+			ms = append(ms, items[idx].Name)
+		}
+	}
+
+	if len(ms) > maxMatches {
+		ms = ms[:maxMatches]
+	}
+
+	return ms
+}
+
+// WordAt returns the identifier or path at the column of the line and its
+// start and end column.
+func WordAt(line string, col int) (string, int, int) {
+	rs := []rune(line)
+	col = min(max(col, 0), len(rs))
+
+	start := col
+	for start > 0 && isWord(rs[start-1]) {
+		start--
+	}
+
+	end := col
+	for end < len(rs) && isWord(rs[end]) {
+		end++
+	}
+
+	return string(rs[start:end]), start, end
+}
+
+// changed returns the content of an added or removed line and the context of
+// a hunk header.
+func changed(line string) string {
+	switch {
+	case strings.HasPrefix(line, "+++"), strings.HasPrefix(line, "---"):
+		return ""
+	case strings.HasPrefix(line, "+"), strings.HasPrefix(line, "-"):
+		return line[1:]
+	case strings.HasPrefix(line, "@@"):
+		if i := strings.Index(line[2:], "@@"); i != -1 {
+			return line[i+4:]
+		}
+	}
+
+	return ""
+}
+
+func identifiers(line string) []string {
+	var ids []string
+
+	var prev string
+
+	for _, loc := range identifierPattern.FindAllStringIndex(line, -1) {
+		id := line[loc[0]:loc[1]]
+		call := strings.HasPrefix(line[loc[1]:], "(")
+
+		switch {
+		case len(id) < minLength, slices.Contains(keywords, id):
+		case call, slices.Contains(declarations, prev), isMixed(id):
+			ids = append(ids, id)
+		}
+
+		prev = id
+	}
+
+	return ids
+}
+
+// isMixed reports if the identifier is camel case or snake case.
+func isMixed(id string) bool {
+	var lower, upper bool
+
+	for _, r := range id {
+		switch {
+		case unicode.IsLower(r):
+			lower = true
+		case unicode.IsUpper(r):
+			upper = true
+		}
+	}
+
+	trimmed := strings.Trim(id, "_")
+
+	return (lower && upper && !isTitle(id)) || strings.Contains(trimmed, "_")
+}
+
+// isTitle reports if only the first letter is upper case.
+func isTitle(id string) bool {
+	rs := []rune(id)
+
+	return unicode.IsUpper(rs[0]) && strings.ToLower(string(rs[1:])) == string(rs[1:])
+}
+
+func isWord(r rune) bool {
+	return unicode.IsLetter(r) || unicode.IsDigit(r) || strings.ContainsRune("_./-", r)
+}
+
+func hasPrefix(s, prefix string) bool {
+	return strings.HasPrefix(strings.ToLower(s), strings.ToLower(prefix))
+}
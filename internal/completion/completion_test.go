@@ -0,0 +1,241 @@
+package completion_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/completion"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+const testDiff = `diff --git a/internal/ui/body/body.go b/internal/ui/body/body.go
+index 1111111..2222222 100644
+--- a/internal/ui/body/body.go
++++ b/internal/ui/body/body.go
+@@ -10,0 +11,4 @@ func (m Model) View() string {
++func (m *Model) ReplaceWord(str string) {
++	_, start, end := spell.WordAt(m.line(), m.column())
++	// Replace the word with a suggestion.
++	m.replace(start, end, str)
+@@ -20 +24 @@ type Model struct {
+-	text_area string
++	textArea textarea.Model
+`
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		wt   repository.Worktree
+		want []completion.Item
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "paths",
+			wt: repository.Worktree{
+				Status: git.Status{
+					"main.go":        &git.FileStatus{Staging: git.Modified},
+					"cmd/root.go":    &git.FileStatus{Staging: git.Added},
+					"internal/ui.go": &git.FileStatus{Staging: git.Untracked},
+				},
+			},
+			want: []completion.Item{
+				{Name: "cmd/root.go"},
+				{Name: "internal/ui.go"},
+				{Name: "main.go"},
+			},
+		},
+		{
+			name: "paths_identifiers",
+			wt: repository.Worktree{
+				Status: git.Status{
+					"main.go": &git.FileStatus{Staging: git.Modified},
+				},
+				Differ: func() string { return "+func main() {\n+\trunApp(main)\n" },
+			},
+			want: []completion.Item{
+				{Name: "main.go"},
+				{Name: "main"},
+				{Name: "runApp"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, completion.New(tt.wt))
+		})
+	}
+}
+
+func TestIdentifiers(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		diff string
+		want []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "diff",
+			diff: testDiff,
+			want: []string{
+				"View", "ReplaceWord", "WordAt", "line", "column", "replace",
+				"Model", "text_area", "textArea",
+			},
+		},
+		{
+			name: "keywords",
+			diff: "+if (ok) {\n+\tfor (i = 0; i < n; i++) {\n+\t\treturn (value)\n",
+		},
+		{
+			name: "declarations",
+			diff: "+type Config struct {\n+const maxWidth = 72\n+var width int\n",
+			want: []string{"Config", "maxWidth", "width"},
+		},
+		{
+			name: "unchanged",
+			diff: " func unchanged() {\n+++ b/file_name.go\n",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, completion.Identifiers(tt.diff))
+		})
+	}
+}
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	items := []completion.Item{
+		{Name: "internal/ui/body/body.go"},
+		{Name: "internal/ui/header/header.go"},
+		{Name: "ReplaceWord"},
+		{Name: "replace"},
+		{Name: "WordAt"},
+	}
+
+	tests := []struct {
+		name string
+		term string
+		want []string
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "prefix",
+			term: "re",
+			want: []string{"ReplaceWord", "replace"},
+		},
+		{
+			name: "prefix_path",
+			term: "bo",
+			want: []string{"internal/ui/body/body.go"},
+		},
+		{
+			name: "fuzzy",
+			term: "wordat",
+			want: []string{"WordAt"},
+		},
+		{
+			name: "fuzzy_path",
+			term: "header.go",
+			want: []string{"internal/ui/header/header.go"},
+		},
+		{
+			name: "none",
+			term: "zzz",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, completion.Match(tt.term, items))
+		})
+	}
+}
+
+func TestWordAt(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		word  string
+		start int
+		end   int
+	}
+
+	tests := []struct {
+		name string
+		line string
+		col  int
+		want want
+	}{
+		{
+			name: "identifier",
+			line: "call Repl",
+			col:  9,
+			want: want{word: "Repl", start: 5, end: 9},
+		},
+		{
+			name: "path",
+			line: "edit internal/ui/bo",
+			col:  19,
+			want: want{word: "internal/ui/bo", start: 5, end: 19},
+		},
+		{
+			name: "snake_case",
+			line: "text_ar",
+			col:  7,
+			want: want{word: "text_ar", start: 0, end: 7},
+		},
+		{
+			name: "space",
+			line: "call ",
+			col:  5,
+			want: want{start: 5, end: 5},
+		},
+		{
+			name: "parenthesis",
+			line: "(Repl",
+			col:  5,
+			want: want{word: "Repl", start: 1, end: 5},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			word, start, end := completion.WordAt(tt.line, tt.col)
+
+			assert.Equal(t, tt.want.word, word)
+			assert.Equal(t, tt.want.start, start)
+			assert.Equal(t, tt.want.end, end)
+		})
+	}
+}
@@ -0,0 +1,15 @@
+package config
+
+import (
+	"github.com/mikelorant/committed/internal/repository"
+)
+
+// AuthorRule selects the default author when a remote of the repository or
+// the path of the worktree matches. Remotes are matched as host and path
+// without the scheme, user or ".git" suffix such as "github.com/acme/*".
+// Paths are matched against the worktree root and may begin with "~/".
+type AuthorRule struct {
+	Author  repository.User `yaml:"author"`
+	Remotes []string        `yaml:"remotes,omitempty"`
+	Paths   []string        `yaml:"paths,omitempty"`
+}
@@ -0,0 +1,13 @@
+package config
+
+// Changelog orders and names the sections of the changelog.
+type Changelog struct {
+	Sections []ChangelogSection `yaml:"sections,omitempty"`
+}
+
+// ChangelogSection groups the commits with any of the emojis under the
+// heading.
+type ChangelogSection struct {
+	Heading string   `yaml:"heading"`
+	Emojis  []string `yaml:"emojis"`
+}
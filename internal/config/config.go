@@ -11,10 +11,16 @@ import (
 )
 
 type Config struct {
-	View    View              `yaml:"view,omitempty"`
-	Commit  Commit            `yaml:"commit,omitempty"`
-	Authors []repository.User `yaml:"authors,omitempty"`
-	Update  bool              `yaml:"-"`
+	View        View              `yaml:"view,omitempty"`
+	Commit      Commit            `yaml:"commit,omitempty"`
+	Authors     []repository.User `yaml:"authors,omitempty"`
+	AuthorRules []AuthorRule      `yaml:"authorRules,omitempty"`
+	Themes      []CustomTheme     `yaml:"themes,omitempty"`
+	Keys        Keys              `yaml:"keys,omitempty"`
+	Generators  []Generator       `yaml:"generators,omitempty"`
+	EmojiRules  []EmojiRule       `yaml:"emojiRules,omitempty"`
+	Changelog   Changelog         `yaml:"changelog,omitempty"`
+	Update      bool              `yaml:"-"`
 }
 
 type View struct {
@@ -26,11 +32,16 @@ type View struct {
 	Colour             Colour        `yaml:"colour,omitempty"`
 	HighlightActive    bool          `yaml:"highlightActive,omitempty"`
 	IgnoreGlobalAuthor bool          `yaml:"ignoreGlobalAuthor,omitempty"`
+	EditMode           EditMode      `yaml:"editMode,omitempty"`
+	SpellCheck         bool          `yaml:"spellCheck,omitempty"`
 }
 
 type Commit struct {
-	EmojiType EmojiType `yaml:"emojiType,omitempty"`
-	Signoff   bool      `yaml:"signoff,omitempty"`
+	EmojiType         EmojiType    `yaml:"emojiType,omitempty"`
+	Signoff           bool         `yaml:"signoff,omitempty"`
+	Reflow            bool         `yaml:"reflow,omitempty"`
+	HookFallback      HookFallback `yaml:"hookFallback,omitempty"`
+	ProtectedBranches []string     `yaml:"protectedBranches,omitempty"`
 }
 
 func (c *Config) Load(fh io.Reader) (Config, error) {
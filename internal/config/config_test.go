@@ -6,6 +6,7 @@ import (
 	"io"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/repository"
@@ -236,6 +237,26 @@ func TestLoad(t *testing.T) {
 			data:   "commit: {emojiType: invalid}",
 			config: config.Config{Commit: config.Commit{EmojiType: config.EmojiTypeUnset}},
 		},
+		{
+			name:   "hookfallback_keep",
+			data:   "commit: {hookFallback: keep}",
+			config: config.Config{Commit: config.Commit{HookFallback: config.HookFallbackKeep}},
+		},
+		{
+			name:   "hookfallback_apply",
+			data:   "commit: {hookFallback: apply}",
+			config: config.Config{Commit: config.Commit{HookFallback: config.HookFallbackApply}},
+		},
+		{
+			name:   "hookfallback_invalid",
+			data:   "commit: {hookFallback: invalid}",
+			config: config.Config{Commit: config.Commit{HookFallback: config.HookFallbackUnset}},
+		},
+		{
+			name:   "protected_branches",
+			data:   "commit: {protectedBranches: [main, release/*]}",
+			config: config.Config{Commit: config.Commit{ProtectedBranches: []string{"main", "release/*"}}},
+		},
 		{
 			name:   "signoff_empty",
 			data:   "commit: {signoff:}",
@@ -257,6 +278,16 @@ func TestLoad(t *testing.T) {
 			config: config.Config{Commit: config.Commit{Signoff: false}},
 			err:    new(yaml.TypeError),
 		},
+		{
+			name:   "spell_check_true",
+			data:   "view: {spellCheck: true}",
+			config: config.Config{View: config.View{SpellCheck: true}},
+		},
+		{
+			name:   "reflow_true",
+			data:   "commit: {reflow: true}",
+			config: config.Config{Commit: config.Commit{Reflow: true}},
+		},
 		{
 			name:   "theme_empty",
 			data:   "view: {theme:}",
@@ -348,6 +379,120 @@ func TestLoad(t *testing.T) {
 				{Name: "John Doe", Email: "jdoe@example.org"},
 			}},
 		},
+		{
+			name: "themes",
+			data: heredoc.Doc(`
+				themes:
+				- id: custom
+				  name: Custom
+				  base: builtin_dark
+				  palette:
+				      fg: "#ffffff"
+				      brightRed: "#ff0000"
+				  components:
+				      header.CounterWarning: "#ffa500"
+			`),
+			config: config.Config{Themes: []config.CustomTheme{
+				{
+					ID:   "custom",
+					Name: "Custom",
+					Base: "builtin_dark",
+					Palette: config.Palette{
+						Fg:        "#ffffff",
+						BrightRed: "#ff0000",
+					},
+					Components: map[string]string{
+						"header.CounterWarning": "#ffa500",
+					},
+				},
+			}},
+		},
+		{
+			name: "keys",
+			data: heredoc.Doc(`
+				keys:
+				    preset: vim
+				    bindings:
+				        amend: ctrl+a
+				        commit: [alt+w, alt+enter]
+			`),
+			config: config.Config{Keys: config.Keys{
+				Preset: config.KeyPresetVim,
+				Bindings: map[string]config.KeyList{
+					"amend":  {"ctrl+a"},
+					"commit": {"alt+w", "alt+enter"},
+				},
+			}},
+		},
+		{
+			name: "generators",
+			data: heredoc.Doc(`
+				generators:
+				- name: summary
+				  command: ./scripts/summary.sh
+				  timeout: 5s
+				- name: ai
+				  command: ai-commit --json
+			`),
+			config: config.Config{Generators: []config.Generator{
+				{Name: "summary", Command: "./scripts/summary.sh", Timeout: 5 * time.Second},
+				{Name: "ai", Command: "ai-commit --json"},
+			}},
+		},
+		{
+			name: "emoji_rules",
+			data: heredoc.Doc(`
+				emojiRules:
+				- emoji: ":white_check_mark:"
+				  paths:
+				  - "*_test.go"
+				- emoji: ":fire:"
+				  change: deleted
+				  all: true
+			`),
+			config: config.Config{EmojiRules: []config.EmojiRule{
+				{Emoji: ":white_check_mark:", Paths: []string{"*_test.go"}},
+				{Emoji: ":fire:", Change: config.ChangeDeleted, All: true},
+			}},
+		},
+		{
+			name: "changelog",
+			data: heredoc.Doc(`
+				changelog:
+				  sections:
+				  - heading: Features
+				    emojis:
+				    - ":sparkles:"
+				    - ":tada:"
+				  - heading: Fixes
+				    emojis:
+				    - 🐛
+			`),
+			config: config.Config{Changelog: config.Changelog{Sections: []config.ChangelogSection{
+				{Heading: "Features", Emojis: []string{":sparkles:", ":tada:"}},
+				{Heading: "Fixes", Emojis: []string{"🐛"}},
+			}}},
+		},
+		{
+			name: "author_rules",
+			data: heredoc.Doc(`
+				authorRules:
+				- author:
+				    name: John Doe
+				    email: john.doe@acme.com
+				  remotes:
+				  - github.com/acme/*
+				  paths:
+				  - ~/work/**
+			`),
+			config: config.Config{AuthorRules: []config.AuthorRule{
+				{
+					Author:  repository.User{Name: "John Doe", Email: "john.doe@acme.com"},
+					Remotes: []string{"github.com/acme/*"},
+					Paths:   []string{"~/work/**"},
+				},
+			}},
+		},
 		{
 			name: "all",
 			data: heredoc.Doc(`
@@ -493,6 +638,60 @@ func TestSave(t *testing.T) {
 				{}
 			`),
 		},
+		{
+			name: "generators",
+			config: func(c *config.Config) {
+				c.Generators = []config.Generator{{Name: "summary", Command: "summary.sh", Timeout: 5 * time.Second}}
+			},
+			data: heredoc.Doc(`
+				generators:
+					- name: summary
+					  command: summary.sh
+					  timeout: 5s
+			`),
+		},
+		{
+			name: "emoji_rules",
+			config: func(c *config.Config) {
+				c.EmojiRules = []config.EmojiRule{{Emoji: ":fire:", Change: config.ChangeDeleted, All: true}}
+			},
+			data: heredoc.Doc(`
+				emojiRules:
+					- emoji: ':fire:'
+					  change: deleted
+					  all: true
+			`),
+		},
+		{
+			name: "changelog",
+			config: func(c *config.Config) {
+				c.Changelog.Sections = []config.ChangelogSection{{Heading: "Fixes", Emojis: []string{":bug:"}}}
+			},
+			data: heredoc.Doc(`
+				changelog:
+					sections:
+						- heading: Fixes
+						  emojis:
+							- ':bug:'
+			`),
+		},
+		{
+			name: "author_rules",
+			config: func(c *config.Config) {
+				c.AuthorRules = []config.AuthorRule{{
+					Author:  repository.User{Name: "John Doe", Email: "john.doe@acme.com"},
+					Remotes: []string{"github.com/acme/*"},
+				}}
+			},
+			data: heredoc.Doc(`
+				authorRules:
+					- author:
+						name: John Doe
+						email: john.doe@acme.com
+					  remotes:
+						- github.com/acme/*
+			`),
+		},
 		{
 			name:   "view_focus_unset",
 			config: func(c *config.Config) { c.View.Focus = config.FocusUnset },
@@ -655,6 +854,23 @@ func TestSave(t *testing.T) {
 					emojiType: character
 			`),
 		},
+		{
+			name:   "hookfallback_apply",
+			config: func(c *config.Config) { c.Commit.HookFallback = config.HookFallbackApply },
+			data: heredoc.Doc(`
+				commit:
+					hookFallback: apply
+			`),
+		},
+		{
+			name:   "protected_branches",
+			config: func(c *config.Config) { c.Commit.ProtectedBranches = []string{"main"} },
+			data: heredoc.Doc(`
+				commit:
+					protectedBranches:
+						- main
+			`),
+		},
 		{
 			name: "authors_one",
 			config: func(c *config.Config) {
@@ -0,0 +1,10 @@
+package config
+
+import "time"
+
+// Generator is an external command that suggests commit messages.
+type Generator struct {
+	Name    string        `yaml:"name"`
+	Command string        `yaml:"command"`
+	Timeout time.Duration `yaml:"timeout,omitempty"`
+}
@@ -0,0 +1,40 @@
+package config
+
+import (
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// HookFallback is the behaviour of the hook when there is no terminal.
+type HookFallback int
+
+const (
+	HookFallbackUnset HookFallback = iota
+	HookFallbackKeep
+	HookFallbackApply
+)
+
+func (f *HookFallback) UnmarshalYAML(value *yaml.Node) error {
+	*f = ParseHookFallback(value.Value)
+
+	return nil
+}
+
+func (f HookFallback) MarshalYAML() (interface{}, error) {
+	return []string{
+		"",
+		"keep",
+		"apply",
+	}[f], nil
+}
+
+func ParseHookFallback(str string) HookFallback {
+	fallback := map[string]HookFallback{
+		"":      HookFallbackUnset,
+		"keep":  HookFallbackKeep,
+		"apply": HookFallbackApply,
+	}
+
+	return fallback[strings.ToLower(str)]
+}
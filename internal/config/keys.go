@@ -0,0 +1,82 @@
+package config
+
+import (
+	"fmt"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+type KeyPreset int
+
+const (
+	KeyPresetUnset KeyPreset = iota
+	KeyPresetDefault
+	KeyPresetVim
+	KeyPresetEmacs
+)
+
+type Keys struct {
+	Preset   KeyPreset          `yaml:"preset,omitempty"`
+	Bindings map[string]KeyList `yaml:"bindings,omitempty"`
+}
+
+// KeyList is a list of keys that accepts either a single key or a sequence of
+// keys.
+type KeyList []string
+
+func (k *KeyPreset) UnmarshalYAML(value *yaml.Node) error {
+	*k = ParseKeyPreset(value.Value)
+
+	return nil
+}
+
+func (k KeyPreset) MarshalYAML() (interface{}, error) {
+	return []string{
+		"",
+		"default",
+		"vim",
+		"emacs",
+	}[k], nil
+}
+
+func (k KeyPreset) Default() int {
+	return 1
+}
+
+func (k KeyPreset) Index() int {
+	if k == KeyPresetUnset {
+		return k.Default()
+	}
+
+	return int(k)
+}
+
+func (k *KeyList) UnmarshalYAML(value *yaml.Node) error {
+	switch value.Kind {
+	case yaml.ScalarNode:
+		*k = KeyList{value.Value}
+	case yaml.SequenceNode:
+		var keys []string
+		if err := value.Decode(&keys); err != nil {
+			return fmt.Errorf("unable to decode keys: %w", err)
+		}
+
+		*k = keys
+	default:
+		return fmt.Errorf("invalid keys on line %d", value.Line)
+	}
+
+	return nil
+}
+
+func ParseKeyPreset(str string) KeyPreset {
+	preset := map[string]KeyPreset{
+		"":        KeyPresetUnset,
+		"default": KeyPresetDefault,
+		"vim":     KeyPresetVim,
+		"emacs":   KeyPresetEmacs,
+	}
+
+	return preset[strings.ToLower(str)]
+}
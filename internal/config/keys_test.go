@@ -0,0 +1,125 @@
+package config_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestUnmarshallYAMLKeyPreset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  config.KeyPreset
+	}{
+		{name: "empty", input: "", want: config.KeyPresetUnset},
+		{name: "default", input: "default", want: config.KeyPresetDefault},
+		{name: "vim", input: "vim", want: config.KeyPresetVim},
+		{name: "emacs", input: "emacs", want: config.KeyPresetEmacs},
+		{name: "invalid", input: "invalid", want: config.KeyPresetUnset},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got config.KeyPreset
+
+			yaml.Unmarshal([]byte(tt.input), &got)
+			assert.Equal(t, tt.want, got, tt.name)
+		})
+	}
+}
+
+func TestMarshallYAMLKeyPreset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input config.KeyPreset
+		want  string
+	}{
+		{name: "empty", input: config.KeyPresetUnset, want: "\"\"\n"},
+		{name: "default", input: config.KeyPresetDefault, want: "default\n"},
+		{name: "vim", input: config.KeyPresetVim, want: "vim\n"},
+		{name: "emacs", input: config.KeyPresetEmacs, want: "emacs\n"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, _ := yaml.Marshal(&tt.input)
+			assert.Equal(t, tt.want, string(got), tt.name)
+		})
+	}
+}
+
+func TestIndexKeyPreset(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input config.KeyPreset
+		want  int
+	}{
+		{name: "unset", input: config.KeyPresetUnset, want: 1},
+		{name: "default", input: config.KeyPresetDefault, want: 1},
+		{name: "vim", input: config.KeyPresetVim, want: 2},
+		{name: "emacs", input: config.KeyPresetEmacs, want: 3},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.input.Index())
+		})
+	}
+}
+
+func TestUnmarshallYAMLKeyList(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  config.KeyList
+		err   string
+	}{
+		{name: "empty", input: "", want: nil},
+		{name: "single", input: "alt+a", want: config.KeyList{"alt+a"}},
+		{name: "multiple", input: "[alt+a, ctrl+a]", want: config.KeyList{"alt+a", "ctrl+a"}},
+		{name: "invalid", input: "key: alt+a", err: "invalid keys on line 1"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got config.KeyList
+
+			err := yaml.Unmarshal([]byte(tt.input), &got)
+			if tt.err != "" {
+				assert.EqualError(t, err, tt.err)
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got, tt.name)
+		})
+	}
+}
@@ -0,0 +1,73 @@
+package config
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Change is the type of change of a staged file.
+type Change int
+
+const (
+	ChangeUnset Change = iota
+	ChangeAdded
+	ChangeModified
+	ChangeDeleted
+	ChangeRenamed
+)
+
+// EmojiRule suggests an emoji when the staged files match the paths and the
+// change. All requires every staged file to match rather than any file.
+type EmojiRule struct {
+	Emoji  string   `yaml:"emoji"`
+	Paths  []string `yaml:"paths,omitempty"`
+	Change Change   `yaml:"change,omitempty"`
+	All    bool     `yaml:"all,omitempty"`
+}
+
+func (c *Change) UnmarshalYAML(value *yaml.Node) error {
+	*c = ParseChange(value.Value)
+
+	return nil
+}
+
+func (c Change) MarshalYAML() (interface{}, error) {
+	return []string{
+		"",
+		"added",
+		"modified",
+		"deleted",
+		"renamed",
+	}[c], nil
+}
+
+func ParseChange(str string) Change {
+	change := map[string]Change{
+		"":         ChangeUnset,
+		"added":    ChangeAdded,
+		"modified": ChangeModified,
+		"deleted":  ChangeDeleted,
+		"renamed":  ChangeRenamed,
+	}
+
+	return change[strings.ToLower(str)]
+}
+
+// LoadEmojiRules decodes a list of emoji rules.
+func LoadEmojiRules(fh io.Reader) ([]EmojiRule, error) {
+	var rules []EmojiRule
+
+	err := yaml.NewDecoder(fh).Decode(&rules)
+	switch {
+	case err == nil:
+	case errors.Is(err, io.EOF):
+	default:
+		return nil, fmt.Errorf("unable to decode emoji rules: %w", err)
+	}
+
+	return rules, nil
+}
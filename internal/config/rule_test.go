@@ -0,0 +1,122 @@
+package config_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+
+	"github.com/stretchr/testify/assert"
+	"gopkg.in/yaml.v3"
+)
+
+func TestUnmarshallYAMLChange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  config.Change
+	}{
+		{name: "empty", input: "", want: config.ChangeUnset},
+		{name: "added", input: "added", want: config.ChangeAdded},
+		{name: "modified", input: "modified", want: config.ChangeModified},
+		{name: "deleted", input: "deleted", want: config.ChangeDeleted},
+		{name: "renamed", input: "renamed", want: config.ChangeRenamed},
+		{name: "uppercase", input: "Deleted", want: config.ChangeDeleted},
+		{name: "invalid", input: "invalid", want: config.ChangeUnset},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got config.Change
+
+			yaml.Unmarshal([]byte(tt.input), &got)
+			assert.Equal(t, tt.want, got, tt.name)
+		})
+	}
+}
+
+func TestMarshallYAMLChange(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input config.Change
+		want  string
+	}{
+		{name: "empty", input: config.ChangeUnset, want: "\"\"\n"},
+		{name: "added", input: config.ChangeAdded, want: "added\n"},
+		{name: "modified", input: config.ChangeModified, want: "modified\n"},
+		{name: "deleted", input: config.ChangeDeleted, want: "deleted\n"},
+		{name: "renamed", input: config.ChangeRenamed, want: "renamed\n"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, _ := yaml.Marshal(&tt.input)
+			assert.Equal(t, tt.want, string(got), tt.name)
+		})
+	}
+}
+
+func TestLoadEmojiRules(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  []config.EmojiRule
+		err   error
+	}{
+		{
+			name:  "empty",
+			input: "",
+		},
+		{
+			name: "rules",
+			input: strings.Join([]string{
+				"- emoji: \":white_check_mark:\"",
+				"  paths:",
+				"    - \"*_test.go\"",
+				"- emoji: \":fire:\"",
+				"  change: deleted",
+				"  all: true",
+			}, "\n"),
+			want: []config.EmojiRule{
+				{Emoji: ":white_check_mark:", Paths: []string{"*_test.go"}},
+				{Emoji: ":fire:", Change: config.ChangeDeleted, All: true},
+			},
+		},
+		{
+			name:  "invalid",
+			input: "emoji: fire",
+			err:   errors.New("unable to decode emoji rules: yaml: unmarshal errors:\n  line 1: cannot unmarshal !!map into []config.EmojiRule"),
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := config.LoadEmojiRules(strings.NewReader(tt.input))
+			if tt.err != nil {
+				assert.EqualError(t, err, tt.err.Error())
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package config
+
+type CustomTheme struct {
+	ID         string            `yaml:"id"`
+	Name       string            `yaml:"name,omitempty"`
+	Base       string            `yaml:"base,omitempty"`
+	Palette    Palette           `yaml:"palette,omitempty"`
+	Components map[string]string `yaml:"components,omitempty"`
+}
+
+type Palette struct {
+	Fg           string `yaml:"fg,omitempty"`
+	Bg           string `yaml:"bg,omitempty"`
+	SelectionBg  string `yaml:"selectionBg,omitempty"`
+	Cursor       string `yaml:"cursor,omitempty"`
+	Black        string `yaml:"black,omitempty"`
+	Red          string `yaml:"red,omitempty"`
+	Green        string `yaml:"green,omitempty"`
+	Yellow       string `yaml:"yellow,omitempty"`
+	Blue         string `yaml:"blue,omitempty"`
+	Purple       string `yaml:"purple,omitempty"`
+	Cyan         string `yaml:"cyan,omitempty"`
+	White        string `yaml:"white,omitempty"`
+	BrightBlack  string `yaml:"brightBlack,omitempty"`
+	BrightRed    string `yaml:"brightRed,omitempty"`
+	BrightGreen  string `yaml:"brightGreen,omitempty"`
+	BrightYellow string `yaml:"brightYellow,omitempty"`
+	BrightBlue   string `yaml:"brightBlue,omitempty"`
+	BrightPurple string `yaml:"brightPurple,omitempty"`
+	BrightCyan   string `yaml:"brightCyan,omitempty"`
+	BrightWhite  string `yaml:"brightWhite,omitempty"`
+}
+
+func (p Palette) IsZero() bool {
+	return p == Palette{}
+}
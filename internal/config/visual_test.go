@@ -248,3 +248,80 @@ func TestIndexFocus(t *testing.T) {
 		})
 	}
 }
+
+func TestUnmarshallYAMLEditMode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input string
+		want  config.EditMode
+	}{
+		{name: "empty", input: "", want: config.EditModeUnset},
+		{name: "default", input: "default", want: config.EditModeDefault},
+		{name: "vim", input: "vim", want: config.EditModeVim},
+		{name: "invalid", input: "invalid", want: config.EditModeUnset},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got config.EditMode
+
+			yaml.Unmarshal([]byte(tt.input), &got)
+			assert.Equal(t, tt.want, got, tt.name)
+		})
+	}
+}
+
+func TestMarshallYAMLEditMode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input config.EditMode
+		want  string
+	}{
+		{name: "empty", input: config.EditModeUnset, want: "\"\"\n"},
+		{name: "default", input: config.EditModeDefault, want: "default\n"},
+		{name: "vim", input: config.EditModeVim, want: "vim\n"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, _ := yaml.Marshal(&tt.input)
+			assert.Equal(t, tt.want, string(got), tt.name)
+		})
+	}
+}
+
+func TestIndexEditMode(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		input config.EditMode
+		want  int
+	}{
+		{name: "unset", input: config.EditModeUnset, want: 1},
+		{name: "default", input: config.EditModeDefault, want: 1},
+		{name: "vim", input: config.EditModeVim, want: 2},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.input.Index())
+		})
+	}
+}
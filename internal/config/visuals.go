@@ -10,6 +10,7 @@ type (
 	Compatibility int
 	Theme         int
 	Colour        int
+	EditMode      int
 )
 
 const (
@@ -32,6 +33,12 @@ const (
 	ColourLight
 )
 
+const (
+	EditModeUnset EditMode = iota
+	EditModeDefault
+	EditModeVim
+)
+
 type Focus int
 
 func (f *Focus) UnmarshalYAML(value *yaml.Node) error {
@@ -114,6 +121,32 @@ func (c Colour) Index() int {
 	return int(c)
 }
 
+func (e *EditMode) UnmarshalYAML(value *yaml.Node) error {
+	*e = ParseEditMode(value.Value)
+
+	return nil
+}
+
+func (e EditMode) MarshalYAML() (interface{}, error) {
+	return []string{
+		"",
+		"default",
+		"vim",
+	}[e], nil
+}
+
+func (e EditMode) Default() int {
+	return 1
+}
+
+func (e EditMode) Index() int {
+	if e == EditModeUnset {
+		return e.Default()
+	}
+
+	return int(e)
+}
+
 func ParseFocus(str string) Focus {
 	focus := map[string]Focus{
 		"":        FocusUnset,
@@ -145,3 +178,13 @@ func ParseColour(str string) Colour {
 
 	return colour[strings.ToLower(str)]
 }
+
+func ParseEditMode(str string) EditMode {
+	mode := map[string]EditMode{
+		"":        EditModeUnset,
+		"default": EditModeDefault,
+		"vim":     EditModeVim,
+	}
+
+	return mode[strings.ToLower(str)]
+}
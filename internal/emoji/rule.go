@@ -0,0 +1,115 @@
+package emoji
+
+import (
+	"path"
+	"slices"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/glob"
+
+	"github.com/go-git/go-git/v5"
+)
+
+// DefaultRules are used after the configured rules. Emoji sets use different
+// shortcodes so a rule is skipped when the emoji is not in the set.
+var DefaultRules = []config.EmojiRule{
+	{Emoji: ":fire:", Change: config.ChangeDeleted, All: true},
+	{Emoji: ":white_check_mark:", Paths: testPaths},
+	{Emoji: ":test_tube:", Paths: testPaths},
+	{Emoji: ":memo:", Paths: docPaths},
+	{Emoji: ":book:", Paths: docPaths},
+	{Emoji: ":books:", Paths: docPaths},
+	{Emoji: ":arrow_up:", Paths: []string{"go.mod", "go.sum"}},
+	{Emoji: ":construction_worker:", Paths: []string{".github/workflows/**", ".gitlab-ci.yml"}},
+	{Emoji: ":see_no_evil:", Paths: []string{".gitignore"}},
+}
+
+var (
+	testPaths = []string{"*_test.go", "*.test.*", "*.spec.*", "test_*.py"}
+	docPaths  = []string{"docs/**", "*.md"}
+)
+
+var changes = map[git.StatusCode]config.Change{
+	git.Added:    config.ChangeAdded,
+	git.Modified: config.ChangeModified,
+	git.Deleted:  config.ChangeDeleted,
+	git.Renamed:  config.ChangeRenamed,
+}
+
+// Suggest returns the emojis of the rules matching the staged files in the
+// order of the rules.
+func (es *Set) Suggest(rules []config.EmojiRule, status git.Status) []Emoji {
+	staged := make(map[string]config.Change)
+
+	for p, s := range status {
+		if s.Staging == git.Unmodified || s.Staging == git.Untracked {
+			continue
+		}
+
+		staged[p] = changes[s.Staging]
+	}
+
+	if len(staged) == 0 {
+		return nil
+	}
+
+	var emojis []Emoji
+
+	for _, r := range rules {
+		if !matchRule(r, staged) {
+			continue
+		}
+
+		e := es.Find(r.Emoji)
+		if !e.Valid || slices.Contains(emojis, e.Emoji) {
+			continue
+		}
+
+		emojis = append(emojis, e.Emoji)
+	}
+
+	return emojis
+}
+
+func matchRule(r config.EmojiRule, staged map[string]config.Change) bool {
+	for p, c := range staged {
+		ok := matchPaths(r.Paths, p) && (r.Change == config.ChangeUnset || r.Change == c)
+
+		switch {
+		case ok && !r.All:
+			return true
+		case !ok && r.All:
+			return false
+		}
+	}
+
+	return r.All
+}
+
+// matchPaths reports if the path matches any of the patterns. Patterns
+// without a slash match the file name and a double asterisk matches any
+// number of directories. No patterns match every path.
+func matchPaths(patterns []string, p string) bool {
+	if len(patterns) == 0 {
+		return true
+	}
+
+	for _, pat := range patterns {
+		if matchPath(pat, p) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func matchPath(pattern, p string) bool {
+	if !strings.Contains(pattern, "/") {
+		ok, _ := path.Match(pattern, path.Base(p))
+
+		return ok
+	}
+
+	return glob.Match(pattern, p)
+}
@@ -0,0 +1,224 @@
+package emoji_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/emoji"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSuggest(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		rules  []config.EmojiRule
+		status git.Status
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want []string
+	}{
+		{
+			name: "empty",
+			args: args{
+				rules: emoji.DefaultRules,
+			},
+		},
+		{
+			name: "unstaged",
+			args: args{
+				rules: emoji.DefaultRules,
+				status: git.Status{
+					"main_test.go": &git.FileStatus{Staging: git.Unmodified, Worktree: git.Modified},
+					"README.md":    &git.FileStatus{Staging: git.Untracked, Worktree: git.Untracked},
+				},
+			},
+		},
+		{
+			name: "test",
+			args: args{
+				rules: emoji.DefaultRules,
+				status: git.Status{
+					"internal/main_test.go": &git.FileStatus{Staging: git.Modified},
+				},
+			},
+			want: []string{":white_check_mark:", ":test_tube:"},
+		},
+		{
+			name: "docs",
+			args: args{
+				rules: emoji.DefaultRules,
+				status: git.Status{
+					"docs/guide/install.txt": &git.FileStatus{Staging: git.Added},
+				},
+			},
+			want: []string{":memo:"},
+		},
+		{
+			name: "go_mod",
+			args: args{
+				rules: emoji.DefaultRules,
+				status: git.Status{
+					"go.mod": &git.FileStatus{Staging: git.Modified},
+					"go.sum": &git.FileStatus{Staging: git.Modified},
+				},
+			},
+			want: []string{":arrow_up:"},
+		},
+		{
+			name: "workflow",
+			args: args{
+				rules: emoji.DefaultRules,
+				status: git.Status{
+					".github/workflows/test.yaml": &git.FileStatus{Staging: git.Modified},
+				},
+			},
+			want: []string{":construction_worker:"},
+		},
+		{
+			name: "deletions",
+			args: args{
+				rules: emoji.DefaultRules,
+				status: git.Status{
+					"main.go":   &git.FileStatus{Staging: git.Deleted},
+					"README.md": &git.FileStatus{Staging: git.Deleted},
+				},
+			},
+			want: []string{":fire:", ":memo:"},
+		},
+		{
+			name: "some_deletions",
+			args: args{
+				rules: emoji.DefaultRules,
+				status: git.Status{
+					"main.go":   &git.FileStatus{Staging: git.Deleted},
+					"README.md": &git.FileStatus{Staging: git.Modified},
+				},
+			},
+			want: []string{":memo:"},
+		},
+		{
+			name: "change",
+			args: args{
+				rules: []config.EmojiRule{
+					{Emoji: ":sparkles:", Change: config.ChangeAdded},
+				},
+				status: git.Status{
+					"main.go": &git.FileStatus{Staging: git.Added},
+				},
+			},
+			want: []string{":sparkles:"},
+		},
+		{
+			name: "change_mismatch",
+			args: args{
+				rules: []config.EmojiRule{
+					{Emoji: ":sparkles:", Change: config.ChangeAdded},
+				},
+				status: git.Status{
+					"main.go": &git.FileStatus{Staging: git.Modified},
+				},
+			},
+		},
+		{
+			name: "order",
+			args: args{
+				rules: append([]config.EmojiRule{
+					{Emoji: ":bug:", Paths: []string{"internal/**/*.go"}},
+				}, emoji.DefaultRules...),
+				status: git.Status{
+					"internal/ui/ui_test.go": &git.FileStatus{Staging: git.Modified},
+				},
+			},
+			want: []string{":bug:", ":white_check_mark:", ":test_tube:"},
+		},
+		{
+			name: "duplicate",
+			args: args{
+				rules: []config.EmojiRule{
+					{Emoji: ":bug:"},
+					{Emoji: "🐛"},
+				},
+				status: git.Status{
+					"main.go": &git.FileStatus{Staging: git.Modified},
+				},
+			},
+			want: []string{":bug:"},
+		},
+		{
+			name: "missing",
+			args: args{
+				rules: []config.EmojiRule{
+					{Emoji: ":unknown:"},
+				},
+				status: git.Status{
+					"main.go": &git.FileStatus{Staging: git.Modified},
+				},
+			},
+		},
+	}
+
+	es := emoji.New()
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var got []string
+			for _, e := range es.Suggest(tt.args.rules, tt.args.status) {
+				got = append(got, e.Shortcode)
+			}
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSuggestPaths(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		pattern string
+		path    string
+		want    bool
+	}{
+		{name: "base", pattern: "*.go", path: "internal/ui/ui.go", want: true},
+		{name: "base_mismatch", pattern: "*.go", path: "internal/ui/ui.yaml"},
+		{name: "path", pattern: "internal/*.go", path: "internal/main.go", want: true},
+		{name: "path_nested", pattern: "internal/*.go", path: "internal/ui/ui.go"},
+		{name: "double", pattern: "internal/**", path: "internal/ui/ui.go", want: true},
+		{name: "double_mismatch", pattern: "internal/**", path: "cmd/root.go"},
+		{name: "double_middle", pattern: "internal/**/*_test.go", path: "internal/ui/ui_test.go", want: true},
+		{name: "double_middle_direct", pattern: "internal/**/*_test.go", path: "internal/ui_test.go", want: true},
+		{name: "double_prefix", pattern: "**/testdata/*", path: "internal/ui/testdata/a.golden", want: true},
+		{name: "quoted", pattern: "a.b/**", path: "axb/c", want: false},
+	}
+
+	es := emoji.New()
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			rules := []config.EmojiRule{
+				{Emoji: ":art:", Paths: []string{tt.pattern}},
+			}
+			status := git.Status{
+				tt.path: &git.FileStatus{Staging: git.Modified},
+			}
+
+			got := es.Suggest(rules, status)
+			assert.Equal(t, tt.want, len(got) == 1)
+		})
+	}
+}
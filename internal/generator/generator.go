@@ -0,0 +1,184 @@
+package generator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os/exec"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/repository"
+)
+
+// Generator suggests commit messages for the staged changes.
+type Generator interface {
+	Generate(ctx context.Context, in Input) ([]Suggestion, error)
+}
+
+// Input is written to the generator as JSON on stdin. This is the contract
+// with external generators so fields may be added but not renamed or removed.
+type Input struct {
+	Diff       string                 `json:"diff"`
+	Repository repository.Description `json:"repository"`
+}
+
+// Suggestion is read from the generator as JSON. The emoji is either a
+// character or a shortcode.
+type Suggestion struct {
+	Emoji   string `json:"emoji,omitempty"`
+	Summary string `json:"summary"`
+	Body    string `json:"body,omitempty"`
+}
+
+// Result is the suggestions or error of a generator.
+type Result struct {
+	Suggestions []Suggestion
+	Err         error
+}
+
+// Command is a generator run by the shell.
+type Command struct {
+	Name    string
+	Command string
+	Timeout time.Duration
+}
+
+const (
+	DefaultTimeout = 10 * time.Second
+
+	// Time to wait for output to close after the command is killed.
+	waitDelay = time.Second
+)
+
+var (
+	ErrTimeout = errors.New("generator timed out")
+	ErrOutput  = errors.New("invalid generator output")
+)
+
+func New(cfg []config.Generator) []Generator {
+	var gs []Generator
+
+	for _, g := range cfg {
+		gs = append(gs, Command{
+			Name:    g.Name,
+			Command: g.Command,
+			Timeout: g.Timeout,
+		})
+	}
+
+	return gs
+}
+
+// Run runs the generators concurrently and returns the results in the order
+// of the generators.
+func Run(ctx context.Context, gs []Generator, in Input) []Result {
+	rs := make([]Result, len(gs))
+
+	var wg sync.WaitGroup
+
+	for i, g := range gs {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			ss, err := g.Generate(ctx, in)
+			rs[i] = Result{Suggestions: ss, Err: err}
+		}()
+	}
+
+	wg.Wait()
+
+	return rs
+}
+
+// Generate runs the command with the input on stdin and parses the
+// suggestions from stdout. The output is either a suggestion or a list of
+// suggestions.
+func (c Command) Generate(ctx context.Context, in Input) ([]Suggestion, error) {
+	data, err := json.Marshal(in)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal generator input: %w", err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, c.timeout())
+	defer cancel()
+
+	var stdout, stderr bytes.Buffer
+
+	cmd := exec.CommandContext(ctx, "sh", "-c", c.Command)
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Stdout = &stdout
+	cmd.Stderr = &stderr
+	cmd.WaitDelay = waitDelay
+
+	err = cmd.Run()
+
+	switch {
+	case errors.Is(ctx.Err(), context.DeadlineExceeded):
+		return nil, fmt.Errorf("%v: %v: %w", c.Name, c.timeout(), ErrTimeout)
+	case err != nil:
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return nil, fmt.Errorf("unable to run generator: %v: %v: %w", c.Name, msg, err)
+		}
+
+		return nil, fmt.Errorf("unable to run generator: %v: %w", c.Name, err)
+	}
+
+	ss, err := parse(stdout.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("%v: %w", c.Name, err)
+	}
+
+	return ss, nil
+}
+
+func (c Command) timeout() time.Duration {
+	if c.Timeout <= 0 {
+		return DefaultTimeout
+	}
+
+	return c.Timeout
+}
+
+func parse(data []byte) ([]Suggestion, error) {
+	data = bytes.TrimSpace(data)
+
+	var ss []Suggestion
+
+	switch {
+	case bytes.HasPrefix(data, []byte("[")):
+		if err := json.Unmarshal(data, &ss); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrOutput, err)
+		}
+	default:
+		var s Suggestion
+		if err := json.Unmarshal(data, &s); err != nil {
+			return nil, fmt.Errorf("%w: %w", ErrOutput, err)
+		}
+
+		ss = []Suggestion{s}
+	}
+
+	var valid []Suggestion
+
+	for _, s := range ss {
+		s.Summary = strings.TrimSpace(s.Summary)
+		if s.Summary == "" {
+			continue
+		}
+
+		valid = append(valid, s)
+	}
+
+	if len(valid) == 0 {
+		return nil, fmt.Errorf("%w: no summary", ErrOutput)
+	}
+
+	return valid, nil
+}
@@ -0,0 +1,177 @@
+package generator_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/generator"
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/go-git/go-git/v5"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockGenerator struct {
+	suggestions []generator.Suggestion
+	err         error
+}
+
+func (m MockGenerator) Generate(_ context.Context, _ generator.Input) ([]generator.Suggestion, error) {
+	return m.suggestions, m.err
+}
+
+var errMock = errors.New("error")
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	gs := generator.New([]config.Generator{
+		{Name: "summary", Command: "summary.sh", Timeout: time.Second},
+	})
+
+	assert.Equal(t, []generator.Generator{
+		generator.Command{Name: "summary", Command: "summary.sh", Timeout: time.Second},
+	}, gs)
+}
+
+func TestCommandGenerate(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		suggestions []generator.Suggestion
+		err         error
+		errString   string
+	}
+
+	tests := []struct {
+		name    string
+		command string
+		timeout time.Duration
+		want    want
+	}{
+		{
+			name:    "object",
+			command: `echo '{"emoji": ":art:", "summary": "Format code", "body": "Run gofmt."}'`,
+			want: want{
+				suggestions: []generator.Suggestion{
+					{Emoji: ":art:", Summary: "Format code", Body: "Run gofmt."},
+				},
+			},
+		},
+		{
+			name:    "list",
+			command: `echo '[{"summary": "First"}, {"summary": " "}, {"summary": "Second"}]'`,
+			want: want{
+				suggestions: []generator.Suggestion{
+					{Summary: "First"},
+					{Summary: "Second"},
+				},
+			},
+		},
+		{
+			name:    "input",
+			command: `in=$(cat); echo "$in" | grep -q '"diff":"+added"' && echo "$in" | grep -q '"branch":{"local":"master"' && echo "$in" | grep -q '"staged":\["main.go"\]' && echo '{"summary": "Input"}'`,
+			want: want{
+				suggestions: []generator.Suggestion{
+					{Summary: "Input"},
+				},
+			},
+		},
+		{
+			name:    "invalid",
+			command: "echo summary",
+			want: want{
+				err: generator.ErrOutput,
+			},
+		},
+		{
+			name:    "no_summary",
+			command: `echo '{"body": "body"}'`,
+			want: want{
+				err:       generator.ErrOutput,
+				errString: "test: invalid generator output: no summary",
+			},
+		},
+		{
+			name:    "exit",
+			command: "echo failure >&2; exit 1",
+			want: want{
+				errString: "unable to run generator: test: failure: exit status 1",
+			},
+		},
+		{
+			name:    "timeout",
+			command: "sleep 5",
+			timeout: 100 * time.Millisecond,
+			want: want{
+				err:       generator.ErrTimeout,
+				errString: "test: 100ms: generator timed out",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := generator.Command{
+				Name:    "test",
+				Command: tt.command,
+				Timeout: tt.timeout,
+			}
+
+			in := generator.Input{
+				Diff: "+added",
+				Repository: repository.Description{
+					Branch: repository.Branch{Local: "master"},
+					Worktree: repository.Worktree{
+						Status: git.Status{
+							"main.go": &git.FileStatus{Staging: git.Modified},
+						},
+					},
+				},
+			}
+
+			ss, err := c.Generate(context.Background(), in)
+			if tt.want.err != nil || tt.want.errString != "" {
+				if tt.want.err != nil {
+					assert.ErrorIs(t, err, tt.want.err)
+				}
+
+				if tt.want.errString != "" {
+					assert.EqualError(t, err, tt.want.errString)
+				}
+
+				assert.Nil(t, ss)
+
+				return
+			}
+
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.suggestions, ss)
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	gs := []generator.Generator{
+		MockGenerator{suggestions: []generator.Suggestion{{Summary: "first"}}},
+		MockGenerator{err: errMock},
+		MockGenerator{suggestions: []generator.Suggestion{{Summary: "second"}}},
+	}
+
+	rs := generator.Run(context.Background(), gs, generator.Input{})
+
+	assert.Equal(t, []generator.Result{
+		{Suggestions: []generator.Suggestion{{Summary: "first"}}},
+		{Err: errMock},
+		{Suggestions: []generator.Suggestion{{Summary: "second"}}},
+	}, rs)
+}
@@ -0,0 +1,51 @@
+package glob
+
+import (
+	"path"
+	"regexp"
+	"strings"
+)
+
+// Match reports if the slash separated name matches the pattern. A double
+// asterisk matches any number of directories.
+func Match(pattern, name string) bool {
+	if !strings.Contains(pattern, "**") {
+		ok, _ := path.Match(pattern, name)
+
+		return ok
+	}
+
+	re, err := regexp.Compile(toRegexp(pattern))
+	if err != nil {
+		return false
+	}
+
+	return re.MatchString(name)
+}
+
+func toRegexp(pattern string) string {
+	var sb strings.Builder
+
+	sb.WriteString("^")
+
+	for i := 0; i < len(pattern); i++ {
+		switch c := pattern[i]; {
+		case strings.HasPrefix(pattern[i:], "**/"):
+			sb.WriteString("(.*/)?")
+			i += 2
+		case strings.HasPrefix(pattern[i:], "**"):
+			sb.WriteString(".*")
+			i++
+		case c == '*':
+			sb.WriteString("[^/]*")
+		case c == '?':
+			sb.WriteString("[^/]")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(c)))
+		}
+	}
+
+	sb.WriteString("$")
+
+	return sb.String()
+}
@@ -0,0 +1,40 @@
+package glob_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/glob"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMatch(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		pattern string
+		path    string
+		want    bool
+	}{
+		{name: "exact", pattern: "go.mod", path: "go.mod", want: true},
+		{name: "star", pattern: "github.com/acme/*", path: "github.com/acme/widget", want: true},
+		{name: "star_nested", pattern: "github.com/acme/*", path: "github.com/acme/widget/tools", want: false},
+		{name: "question", pattern: "a?c", path: "abc", want: true},
+		{name: "double_star", pattern: "/home/user/work/**", path: "/home/user/work/acme/widget", want: true},
+		{name: "double_star_prefix", pattern: "**/widget", path: "/home/user/work/widget", want: true},
+		{name: "double_star_other", pattern: "/home/user/work/**", path: "/home/user/personal/widget", want: false},
+		{name: "quote", pattern: "docs/**/*.md", path: "docs/a+b/readme.md", want: true},
+		{name: "invalid", pattern: "[", path: "[", want: false},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, glob.Match(tt.pattern, tt.path))
+		})
+	}
+}
@@ -84,3 +84,8 @@ func (h *Hook) Do(opts Options) error {
 
 	return ErrAction
 }
+
+// Path returns the location of the hook once installed or uninstalled.
+func (h *Hook) Path() string {
+	return h.Location
+}
@@ -11,7 +11,11 @@
 #
 # Source: https://git-scm.com/docs/githooks#_prepare_commit_msg
 
-exec < /dev/tty
+# Attach to the terminal when there is one. Without a terminal, such as
+# from an IDE or CI, Committed falls back to the hookFallback config.
+if (: < /dev/tty) 2> /dev/null; then
+	exec < /dev/tty
+fi
 
 # name of the file that contains the commit log message
 : "${message_file:=$1}"
@@ -24,23 +28,16 @@ exec < /dev/tty
 
 declare -a args
 
-[[ "$source" = "merge" ]] && exit
-[[ "$source" = "squash" ]] && exit
-[[ "$source" = "template" ]] && exit
-
 if [[ -n ${message_file} ]]; then
 		args+=(--message-file "$message_file")
 fi
 
+if [[ -n "${source}" ]]; then
+		args+=(--source "${source}")
+fi
+
 if [[ -n "${sha}" ]]; then
 		args+=(--sha "${sha}")
 fi
 
-case ${source} in
-	HEAD)
-		args+=(--amend)
-		;;
-	*)
-esac
-
 committed --hook "${args[@]}"
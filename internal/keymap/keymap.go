@@ -0,0 +1,165 @@
+package keymap
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/config"
+)
+
+type Action int
+
+type KeyMap struct {
+	bindings []Binding
+	lookup   map[string]Action
+}
+
+type Binding struct {
+	Action      Action
+	Name        string
+	Label       string
+	Description string
+	Keys        []string
+}
+
+const (
+	ActionNone Action = iota
+	ActionCommit
+	ActionAmend
+	ActionLoad
+	ActionSignoff
+	ActionTheme
+	ActionHelp
+	ActionOptions
+	ActionWrite
+	ActionEditor
+	ActionReflow
+	ActionSpelling
+	ActionComplete
+	ActionGenerate
+	ActionBranch
+	ActionFixup
+	ActionAuthor
+	ActionEmoji
+	ActionSummary
+	ActionBody
+	ActionCancel
+	ActionNext
+	ActionPrevious
+)
+
+var (
+	ErrAction   = errors.New("invalid key action")
+	ErrConflict = errors.New("key conflict")
+	ErrReserved = errors.New("key reserved")
+)
+
+// Keys used by components that can not be rebound.
+var reserved = []string{"enter", "esc"}
+
+var defaultKeyMap = mustNew(config.Keys{})
+
+func New(cfg config.Keys) (KeyMap, error) {
+	bs := preset(cfg.Preset)
+
+	names := make([]string, 0, len(cfg.Bindings))
+	for name := range cfg.Bindings {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		idx := slices.IndexFunc(bs, func(b Binding) bool {
+			return b.Name == strings.ToLower(name)
+		})
+		if idx == -1 {
+			return KeyMap{}, fmt.Errorf("%w: %v", ErrAction, name)
+		}
+
+		bs[idx].Keys = slices.Clone([]string(cfg.Bindings[name]))
+	}
+
+	lookup := make(map[string]Action)
+
+	for _, b := range bs {
+		for _, k := range b.Keys {
+			if slices.Contains(reserved, k) {
+				return KeyMap{}, fmt.Errorf("%w: %v: %v", ErrReserved, b.Name, k)
+			}
+
+			if a, ok := lookup[k]; ok && a != b.Action {
+				return KeyMap{}, fmt.Errorf("%w: %v: %v and %v", ErrConflict, k, name(bs, a), b.Name)
+			}
+
+			lookup[k] = b.Action
+		}
+	}
+
+	return KeyMap{
+		bindings: bs,
+		lookup:   lookup,
+	}, nil
+}
+
+// Default returns the key map of the default preset.
+func Default() KeyMap {
+	return defaultKeyMap
+}
+
+// Action returns the action bound to the key. A zero value key map uses the
+// default preset.
+func (k KeyMap) Action(key string) Action {
+	return k.keyMap().lookup[key]
+}
+
+func (k KeyMap) Binding(a Action) Binding {
+	for _, b := range k.keyMap().bindings {
+		if b.Action == a {
+			return b
+		}
+	}
+
+	return Binding{}
+}
+
+func (k KeyMap) Bindings() []Binding {
+	return slices.Clone(k.keyMap().bindings)
+}
+
+// Key returns the primary key of the binding.
+func (b Binding) Key() string {
+	if len(b.Keys) == 0 {
+		return ""
+	}
+
+	return b.Keys[0]
+}
+
+func (k KeyMap) keyMap() KeyMap {
+	if k.lookup == nil {
+		return defaultKeyMap
+	}
+
+	return k
+}
+
+func mustNew(cfg config.Keys) KeyMap {
+	km, err := New(cfg)
+	if err != nil {
+		panic(err)
+	}
+
+	return km
+}
+
+func name(bs []Binding, a Action) string {
+	for _, b := range bs {
+		if b.Action == a {
+			return b.Name
+		}
+	}
+
+	return ""
+}
@@ -0,0 +1,260 @@
+package keymap_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/keymap"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNew(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		actions map[string]keymap.Action
+		err     string
+	}
+
+	tests := []struct {
+		name string
+		keys config.Keys
+		want want
+	}{
+		{
+			name: "default",
+			want: want{
+				actions: map[string]keymap.Action{
+					"alt+enter": keymap.ActionCommit,
+					"alt+\\":    keymap.ActionCommit,
+					"alt+a":     keymap.ActionAmend,
+					"å":         keymap.ActionAmend,
+					"ctrl+o":    keymap.ActionOptions,
+					"ctrl+w":    keymap.ActionWrite,
+					"alt+1":     keymap.ActionAuthor,
+					"tab":       keymap.ActionNext,
+					"shift+tab": keymap.ActionPrevious,
+					"ctrl+c":    keymap.ActionCancel,
+					"alt+w":     keymap.ActionNone,
+					"enter":     keymap.ActionNone,
+				},
+			},
+		},
+		{
+			name: "vim",
+			keys: config.Keys{Preset: config.KeyPresetVim},
+			want: want{
+				actions: map[string]keymap.Action{
+					"alt+w":     keymap.ActionCommit,
+					"alt+enter": keymap.ActionCommit,
+					"alt+q":     keymap.ActionCancel,
+					"ctrl+c":    keymap.ActionCancel,
+					"alt+j":     keymap.ActionNext,
+					"alt+k":     keymap.ActionPrevious,
+					"alt+a":     keymap.ActionAmend,
+				},
+			},
+		},
+		{
+			name: "emacs",
+			keys: config.Keys{Preset: config.KeyPresetEmacs},
+			want: want{
+				actions: map[string]keymap.Action{
+					"ctrl+s":    keymap.ActionCommit,
+					"alt+enter": keymap.ActionCommit,
+					"ctrl+g":    keymap.ActionCancel,
+					"alt+n":     keymap.ActionNext,
+					"alt+p":     keymap.ActionPrevious,
+					"alt+a":     keymap.ActionAmend,
+				},
+			},
+		},
+		{
+			name: "override",
+			keys: config.Keys{
+				Bindings: map[string]config.KeyList{
+					"amend": {"ctrl+a", "alt+m"},
+				},
+			},
+			want: want{
+				actions: map[string]keymap.Action{
+					"ctrl+a": keymap.ActionAmend,
+					"alt+m":  keymap.ActionAmend,
+					"alt+a":  keymap.ActionNone,
+				},
+			},
+		},
+		{
+			name: "override_preset",
+			keys: config.Keys{
+				Preset: config.KeyPresetVim,
+				Bindings: map[string]config.KeyList{
+					"Commit": {"alt+x"},
+				},
+			},
+			want: want{
+				actions: map[string]keymap.Action{
+					"alt+x": keymap.ActionCommit,
+					"alt+w": keymap.ActionNone,
+					"alt+q": keymap.ActionCancel,
+				},
+			},
+		},
+		{
+			name: "unbind",
+			keys: config.Keys{
+				Bindings: map[string]config.KeyList{
+					"theme": {},
+				},
+			},
+			want: want{
+				actions: map[string]keymap.Action{
+					"alt+t": keymap.ActionNone,
+				},
+			},
+		},
+		{
+			name: "conflict",
+			keys: config.Keys{
+				Bindings: map[string]config.KeyList{
+					"amend": {"ctrl+o"},
+				},
+			},
+			want: want{
+				err: "key conflict: ctrl+o: amend and options",
+			},
+		},
+		{
+			name: "conflict_preset",
+			keys: config.Keys{
+				Preset: config.KeyPresetEmacs,
+				Bindings: map[string]config.KeyList{
+					"load": {"ctrl+g"},
+				},
+			},
+			want: want{
+				err: "key conflict: ctrl+g: load and cancel",
+			},
+		},
+		{
+			name: "reserved",
+			keys: config.Keys{
+				Bindings: map[string]config.KeyList{
+					"commit": {"enter"},
+				},
+			},
+			want: want{
+				err: "key reserved: commit: enter",
+			},
+		},
+		{
+			name: "invalid_action",
+			keys: config.Keys{
+				Bindings: map[string]config.KeyList{
+					"invalid": {"alt+x"},
+				},
+			},
+			want: want{
+				err: "invalid key action: invalid",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			km, err := keymap.New(tt.keys)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			for k, v := range tt.want.actions {
+				assert.Equal(t, v, km.Action(k), k)
+			}
+		})
+	}
+}
+
+func TestBinding(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		keymap keymap.KeyMap
+		action keymap.Action
+		want   keymap.Binding
+		key    string
+	}{
+		{
+			name:   "commit",
+			keymap: keymap.Default(),
+			action: keymap.ActionCommit,
+			want: keymap.Binding{
+				Action:      keymap.ActionCommit,
+				Name:        "commit",
+				Label:       "Commit",
+				Description: "Commit",
+				Keys:        []string{"alt+enter", "alt+\\"},
+			},
+			key: "alt+enter",
+		},
+		{
+			name:   "zero_value",
+			action: keymap.ActionWrite,
+			want: keymap.Binding{
+				Action:      keymap.ActionWrite,
+				Name:        "write",
+				Label:       "Write",
+				Description: "Write options",
+				Keys:        []string{"ctrl+w"},
+			},
+			key: "ctrl+w",
+		},
+		{
+			name:   "none",
+			keymap: keymap.Default(),
+			action: keymap.ActionNone,
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.keymap.Binding(tt.action)
+			assert.Equal(t, tt.want, got)
+			assert.Equal(t, tt.key, got.Key())
+		})
+	}
+}
+
+func TestBindings(t *testing.T) {
+	t.Parallel()
+
+	presets := []config.KeyPreset{
+		config.KeyPresetDefault,
+		config.KeyPresetVim,
+		config.KeyPresetEmacs,
+	}
+
+	for _, p := range presets {
+		km, err := keymap.New(config.Keys{Preset: p})
+		assert.NoError(t, err)
+
+		bs := km.Bindings()
+		assert.Len(t, bs, int(keymap.ActionPrevious))
+
+		for _, b := range bs {
+			assert.NotEmpty(t, b.Keys, b.Name)
+			assert.Equal(t, b.Action, km.Action(b.Key()), b.Name)
+		}
+	}
+}
@@ -0,0 +1,226 @@
+package keymap
+
+import (
+	"github.com/mikelorant/committed/internal/config"
+)
+
+// Keys for macOS terminals that send the option character instead of the
+// alt modifier.
+const (
+	optionAmend    = "å"
+	optionLoad     = "¬"
+	optionSignoff  = "ß"
+	optionTheme    = "†"
+	optionAuthor   = "¡"
+	optionEmoji    = "™"
+	optionSummary  = "£"
+	optionBody     = "¢"
+	optionHelp     = "˙"
+	optionOptions  = "ø"
+	optionReflow   = "®"
+	optionSpell    = "Ω"
+	optionGenerate = "©"
+	optionBranch   = "∫"
+	optionFixup    = "ƒ"
+	optionW        = "∑"
+	optionQ        = "œ"
+	optionJ        = "∆"
+	optionK        = "˚"
+	optionP        = "π"
+	optionSlash    = "÷"
+)
+
+func preset(p config.KeyPreset) []Binding {
+	bs := defaultBindings()
+
+	var keys map[Action][]string
+
+	switch p {
+	case config.KeyPresetVim:
+		keys = map[Action][]string{
+			ActionCommit:   {"alt+w", "alt+enter", "alt+\\", optionW},
+			ActionCancel:   {"alt+q", "ctrl+c", optionQ},
+			ActionNext:     {"tab", "alt+j", optionJ},
+			ActionPrevious: {"shift+tab", "alt+k", optionK},
+			ActionComplete: {"ctrl+n"},
+		}
+	case config.KeyPresetEmacs:
+		keys = map[Action][]string{
+			ActionCommit:   {"ctrl+s", "alt+enter", "alt+\\"},
+			ActionCancel:   {"ctrl+g", "ctrl+c"},
+			ActionEditor:   {"ctrl+x"},
+			ActionReflow:   {"alt+q", optionQ},
+			ActionNext:     {"tab", "alt+n"},
+			ActionPrevious: {"shift+tab", "alt+p", optionP},
+			ActionBranch:   {"alt+B"}, // Meta b moves back a word.
+			ActionFixup:    {"alt+F"}, // Meta f moves forward a word.
+		}
+	}
+
+	for i, b := range bs {
+		if k, ok := keys[b.Action]; ok {
+			bs[i].Keys = k
+		}
+	}
+
+	return bs
+}
+
+func defaultBindings() []Binding {
+	return []Binding{
+		{
+			Action:      ActionCommit,
+			Name:        "commit",
+			Label:       "Commit",
+			Description: "Commit",
+			Keys:        []string{"alt+enter", "alt+\\"},
+		},
+		{
+			Action:      ActionAmend,
+			Name:        "amend",
+			Label:       "Amend",
+			Description: "Toggle amend",
+			Keys:        []string{"alt+a", optionAmend},
+		},
+		{
+			Action:      ActionLoad,
+			Name:        "load",
+			Label:       "Load",
+			Description: "Load saved message",
+			Keys:        []string{"alt+l", optionLoad},
+		},
+		{
+			Action:      ActionSignoff,
+			Name:        "signoff",
+			Label:       "Sign-off",
+			Description: "Toggle sign-off",
+			Keys:        []string{"alt+s", optionSignoff},
+		},
+		{
+			Action:      ActionTheme,
+			Name:        "theme",
+			Label:       "Theme",
+			Description: "Toggle theme",
+			Keys:        []string{"alt+t", optionTheme},
+		},
+		{
+			Action:      ActionHelp,
+			Name:        "help",
+			Label:       "Help",
+			Description: "Help",
+			Keys:        []string{"ctrl+h", optionHelp},
+		},
+		{
+			Action:      ActionOptions,
+			Name:        "options",
+			Label:       "Options",
+			Description: "Options",
+			Keys:        []string{"ctrl+o", optionOptions},
+		},
+		{
+			Action:      ActionWrite,
+			Name:        "write",
+			Label:       "Write",
+			Description: "Write options",
+			Keys:        []string{"ctrl+w"},
+		},
+		{
+			Action:      ActionEditor,
+			Name:        "editor",
+			Label:       "Editor",
+			Description: "Open in editor",
+			Keys:        []string{"alt+e"},
+		},
+		{
+			Action:      ActionReflow,
+			Name:        "reflow",
+			Label:       "Reflow",
+			Description: "Reflow body",
+			Keys:        []string{"alt+r", optionReflow},
+		},
+		{
+			Action:      ActionSpelling,
+			Name:        "spelling",
+			Label:       "Spelling",
+			Description: "Spelling suggestions",
+			Keys:        []string{"alt+z", optionSpell},
+		},
+		{
+			Action:      ActionComplete,
+			Name:        "complete",
+			Label:       "Complete",
+			Description: "Complete identifier",
+			Keys:        []string{"alt+/", optionSlash},
+		},
+		{
+			Action:      ActionGenerate,
+			Name:        "generate",
+			Label:       "Generate",
+			Description: "Generate message",
+			Keys:        []string{"alt+g", optionGenerate},
+		},
+		{
+			Action:      ActionBranch,
+			Name:        "branch",
+			Label:       "Branch",
+			Description: "Commit to new branch",
+			Keys:        []string{"alt+b", optionBranch},
+		},
+		{
+			Action:      ActionFixup,
+			Name:        "fixup",
+			Label:       "Fixup",
+			Description: "Fixup earlier commit",
+			Keys:        []string{"alt+f", optionFixup},
+		},
+		{
+			Action:      ActionAuthor,
+			Name:        "author",
+			Label:       "Author",
+			Description: "Focus author",
+			Keys:        []string{"alt+1", optionAuthor},
+		},
+		{
+			Action:      ActionEmoji,
+			Name:        "emoji",
+			Label:       "Emoji",
+			Description: "Focus emoji",
+			Keys:        []string{"alt+2", optionEmoji},
+		},
+		{
+			Action:      ActionSummary,
+			Name:        "summary",
+			Label:       "Summary",
+			Description: "Focus summary",
+			Keys:        []string{"alt+3", optionSummary},
+		},
+		{
+			Action:      ActionBody,
+			Name:        "body",
+			Label:       "Body",
+			Description: "Focus body",
+			Keys:        []string{"alt+4", optionBody},
+		},
+		{
+			Action:      ActionCancel,
+			Name:        "cancel",
+			Label:       "Cancel",
+			Description: "Cancel",
+			Keys:        []string{"ctrl+c"},
+		},
+		{
+			Action:      ActionNext,
+			Name:        "next",
+			Label:       "Next",
+			Description: "Next component",
+			Keys:        []string{"tab"},
+		},
+		{
+			Action:      ActionPrevious,
+			Name:        "previous",
+			Label:       "Previous",
+			Description: "Previous component",
+			Keys:        []string{"shift+tab"},
+		},
+	}
+}
@@ -3,6 +3,8 @@ package repository
 import (
 	"errors"
 	"fmt"
+	"io"
+	"os"
 
 	"github.com/go-git/go-git/v5/config"
 	"github.com/go-git/go-git/v5/plumbing"
@@ -10,16 +12,23 @@ import (
 
 var ErrLocalBranchNotFound = errors.New("local branch not found")
 
+// Branch is the branch of the head. Ahead and Behind are the number of
+// commits the branch and its upstream have that the other does not. URL is
+// the URL of the remote of the upstream.
 type Branch struct {
-	Local  string
-	Remote string
-	Refs   Refs
+	Local    string `json:"local"`
+	Remote   string `json:"remote"`
+	URL      string `json:"url"`
+	Detached bool   `json:"detached"`
+	Ahead    int    `json:"ahead"`
+	Behind   int    `json:"behind"`
+	Refs     Refs   `json:"refs"`
 }
 
 type Refs struct {
-	Locals  []string
-	Remotes []string
-	Tags    []string
+	Locals  []string `json:"locals"`
+	Remotes []string `json:"remotes"`
+	Tags    []string `json:"tags"`
 }
 
 type BranchOptions struct {
@@ -44,17 +53,21 @@ func (r *Repository) Branch() (Branch, error) {
 		return Branch{}, fmt.Errorf("unable to get head reference: %w", err)
 	}
 
+	if h.Name() == plumbing.HEAD {
+		return detached(r.Brancher, h)
+	}
+
 	l, err := local(h)
 	if err != nil {
 		return Branch{}, fmt.Errorf("unable to get local branch: %w", err)
 	}
 
-	rm := remote(l, c)
+	up := upstream(l, c)
 
 	ro := BranchOptions{
 		brancher:     r.Brancher,
 		localBranch:  l,
-		remoteBranch: rm,
+		remoteBranch: up.Short(),
 		headRef:      h,
 	}
 
@@ -63,13 +76,71 @@ func (r *Repository) Branch() (Branch, error) {
 		return Branch{}, fmt.Errorf("unable to get head references: %w", err)
 	}
 
+	ahead, behind, err := tracking(r.Brancher, h, up)
+	if err != nil {
+		return Branch{}, fmt.Errorf("unable to get upstream commits: %w", err)
+	}
+
 	return Branch{
 		Local:  l,
-		Remote: rm,
+		Remote: up.Short(),
+		URL:    remoteURL(l, c),
+		Ahead:  ahead,
+		Behind: behind,
 		Refs:   refs,
 	}, nil
 }
 
+// detached returns the branch of a head that is not a branch. The
+// references of the head include every branch pointing to the commit.
+func detached(b Brancher, h *plumbing.Reference) (Branch, error) {
+	refs, err := headRefs(BranchOptions{
+		brancher: b,
+		headRef:  h,
+	})
+	if err != nil {
+		return Branch{}, fmt.Errorf("unable to get head references: %w", err)
+	}
+
+	return Branch{
+		Detached: true,
+		Refs:     refs,
+	}, nil
+}
+
+// CreateBranch creates a branch at the head and switches to it. The working
+// tree and index are kept so staged changes are committed to the branch.
+func (r *Repository) CreateBranch(name string, out io.Writer) error {
+	if out == nil {
+		out = os.Stdout
+	}
+
+	if err := r.Runner(out, command, []string{"switch", "--create", name}, nil); err != nil {
+		return fmt.Errorf("unable to run command: %w", err)
+	}
+
+	return nil
+}
+
+// RevertBranch switches back to the previous branch and deletes the branch.
+// It undoes CreateBranch when the commit on the new branch fails. The branch
+// is only deleted when it has no commits of its own.
+func (r *Repository) RevertBranch(name string, out io.Writer) error {
+	if out == nil {
+		out = os.Stdout
+	}
+
+	if err := r.Runner(out, command, []string{"checkout", "-"}, nil); err != nil {
+		return fmt.Errorf("unable to run command: %w", err)
+	}
+
+	if err := r.Runner(out, command, []string{"branch", "--delete", name}, nil); err != nil {
+		return fmt.Errorf("unable to run command: %w", err)
+	}
+
+	return nil
+}
+
 func local(ref *plumbing.Reference) (string, error) {
 	r := ref.Name().Short()
 
@@ -80,18 +151,34 @@ func local(ref *plumbing.Reference) (string, error) {
 	return r, nil
 }
 
-func remote(ref string, cfg *config.Config) string {
-	bs := cfg.Branches
+// upstream returns the remote reference of the branch. The merge reference
+// is used when set as the upstream may have a different name.
+func upstream(ref string, cfg *config.Config) plumbing.ReferenceName {
+	b, ok := cfg.Branches[ref]
+	if !ok || b.Remote == "" {
+		return ""
+	}
+
+	name := b.Name
+	if b.Merge != "" {
+		name = b.Merge.Short()
+	}
+
+	return plumbing.NewRemoteReferenceName(b.Remote, name)
+}
 
-	if _, ok := bs[ref]; !ok {
+func remoteURL(ref string, cfg *config.Config) string {
+	b, ok := cfg.Branches[ref]
+	if !ok {
 		return ""
 	}
 
-	if bs[ref].Remote == "" {
+	rm, ok := cfg.Remotes[b.Remote]
+	if !ok || len(rm.URLs) == 0 {
 		return ""
 	}
 
-	return fmt.Sprintf("%s/%s", bs[ref].Remote, bs[ref].Name)
+	return rm.URLs[0]
 }
 
 func headRefs(ro BranchOptions) (Refs, error) {
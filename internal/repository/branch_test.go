@@ -3,6 +3,7 @@ package repository_test
 import (
 	"errors"
 	"fmt"
+	"io"
 	"testing"
 	"time"
 
@@ -24,14 +25,20 @@ type MockRepositoryBranch struct {
 	repo       *git.Repository
 	local      string
 	remote     string
+	url        string
+	head       string
+	upstream   string
+	detached   bool
 	localRefs  []string
 	remoteRefs []string
+	remotes    map[string]string
 	tagRefs    []string
 	idx        int
 
-	configErr error
-	headErr   error
-	refsErr   error
+	configErr    error
+	headErr      error
+	refsErr      error
+	referenceErr error
 }
 
 var errMockBranch = errors.New("error")
@@ -57,6 +64,12 @@ func (m *MockRepositoryBranch) Config() (*config.Config, error) {
 
 	cfg.Branches = bs
 
+	if m.url != "" {
+		cfg.Remotes = map[string]*config.RemoteConfig{
+			m.remote: {Name: m.remote, URLs: []string{m.url}},
+		}
+	}
+
 	return &cfg, nil
 }
 
@@ -67,11 +80,36 @@ func (m *MockRepositoryBranch) Head() (*plumbing.Reference, error) {
 		return &ref, nil
 	}
 
-	hr := plumbing.NewHashReference(plumbing.NewBranchReferenceName(m.local), mockHash)
+	h := mockHash
+	if m.head != "" {
+		h = plumbing.NewHash(m.head)
+	}
+
+	if m.detached {
+		return plumbing.NewHashReference(plumbing.HEAD, h), m.headErr
+	}
+
+	hr := plumbing.NewHashReference(plumbing.NewBranchReferenceName(m.local), h)
 
 	return hr, m.headErr
 }
 
+func (m *MockRepositoryBranch) Reference(name plumbing.ReferenceName, _ bool) (*plumbing.Reference, error) {
+	if m.referenceErr != nil {
+		return nil, m.referenceErr
+	}
+
+	if m.upstream == "" {
+		return nil, plumbing.ErrReferenceNotFound
+	}
+
+	return plumbing.NewHashReference(name, plumbing.NewHash(m.upstream)), nil
+}
+
+func (m *MockRepositoryBranch) CommitObject(h plumbing.Hash) (*object.Commit, error) {
+	return m.repo.CommitObject(h)
+}
+
 func (m *MockRepositoryBranch) TagObject(hash plumbing.Hash) (*object.Tag, error) {
 	var ref *plumbing.Reference
 	var err error
@@ -116,6 +154,15 @@ func (m MockRepositoryBranch) References() (storer.ReferenceIter, error) {
 		rs = append(rs, plumbing.NewHashReference(plumbing.NewRemoteReferenceName(r, m.local), mockHash))
 	}
 
+	if m.remote != "" && m.upstream != "" {
+		up := plumbing.NewRemoteReferenceName(m.remote, m.local)
+		rs = append(rs, plumbing.NewHashReference(up, plumbing.NewHash(m.upstream)))
+	}
+
+	for n, h := range m.remotes {
+		rs = append(rs, plumbing.NewHashReference(plumbing.ReferenceName("refs/remotes/"+n), plumbing.NewHash(h)))
+	}
+
 	for _, r := range m.tagRefs {
 		rs = append(rs, plumbing.NewHashReference(plumbing.NewTagReferenceName(r), mockHash))
 	}
@@ -127,21 +174,30 @@ func TestBranch(t *testing.T) {
 	t.Parallel()
 
 	type args struct {
-		local      string
-		remote     string
-		localRefs  []string
-		remoteRefs []string
-		tagRefs    []string
-		configErr  error
-		headErr    error
-		refsErr    error
+		local        string
+		remote       string
+		url          string
+		head         string
+		upstream     string
+		detached     bool
+		referenceErr error
+		localRefs    []string
+		remoteRefs   []string
+		tagRefs      []string
+		configErr    error
+		headErr      error
+		refsErr      error
 	}
 
 	type want struct {
-		local  string
-		remote string
-		refs   repository.Refs
-		err    string
+		local    string
+		remote   string
+		url      string
+		detached bool
+		ahead    int
+		behind   int
+		refs     repository.Refs
+		err      string
 	}
 
 	tests := []struct {
@@ -179,6 +235,137 @@ func TestBranch(t *testing.T) {
 			},
 		},
 
+		{
+			name: "remote_url",
+			args: args{
+				local:  "master",
+				remote: "origin",
+				url:    "git@github.com:acme/widget.git",
+			},
+			want: want{
+				local:  "master",
+				remote: "origin/master",
+				url:    "git@github.com:acme/widget.git",
+			},
+		},
+
+		// tracking
+		{
+			name: "up_to_date",
+			args: args{
+				local:    "master",
+				remote:   "origin",
+				upstream: "6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+			},
+			want: want{
+				local:  "master",
+				remote: "origin/master",
+			},
+		},
+		{
+			name: "ahead",
+			args: args{
+				local:    "master",
+				remote:   "origin",
+				upstream: "918c48b83bd081e863dbe1b80f8998f058cd8294",
+			},
+			want: want{
+				local:  "master",
+				remote: "origin/master",
+				ahead:  1,
+			},
+		},
+		{
+			name: "ahead_merge",
+			args: args{
+				local:    "master",
+				remote:   "origin",
+				upstream: "b8e471f58bcbca63b07bda20e428190409c2db47",
+			},
+			want: want{
+				local:  "master",
+				remote: "origin/master",
+				ahead:  6,
+			},
+		},
+		{
+			name: "behind",
+			args: args{
+				local:    "master",
+				remote:   "origin",
+				head:     "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				upstream: "6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+			},
+			want: want{
+				local:  "master",
+				remote: "origin/master",
+				behind: 1,
+			},
+		},
+		{
+			name: "ahead_behind",
+			args: args{
+				local:    "master",
+				remote:   "origin",
+				upstream: "e8d3ffab552895c19b9fcf7aa264d277cde33881",
+			},
+			want: want{
+				local:  "master",
+				remote: "origin/master",
+				ahead:  1,
+				behind: 1,
+			},
+		},
+		{
+			name: "upstream_not_fetched",
+			args: args{
+				local:  "master",
+				remote: "origin",
+			},
+			want: want{
+				local:  "master",
+				remote: "origin/master",
+			},
+		},
+		{
+			name: "upstream_error",
+			args: args{
+				local:        "master",
+				remote:       "origin",
+				referenceErr: errMockBranch,
+			},
+			want: want{
+				err: "unable to get upstream commits: unable to get upstream reference: refs/remotes/origin/master: error",
+			},
+		},
+		{
+			name: "upstream_commit_error",
+			args: args{
+				local:    "master",
+				remote:   "origin",
+				upstream: "1234567890abcdef1234567890abcdef12345678",
+			},
+			want: want{
+				err: "unable to get upstream commits: unable to get commit: 1234567890abcdef1234567890abcdef12345678: object not found",
+			},
+		},
+
+		// detached
+		{
+			name: "detached",
+			args: args{
+				local:     "master",
+				detached:  true,
+				localRefs: []string{"master"},
+			},
+			want: want{
+				detached: true,
+				refs: repository.Refs{
+					Locals: []string{"master"},
+				},
+			},
+		},
+
 		// refs local
 		{
 			name: "local_refs",
@@ -372,15 +559,20 @@ func TestBranch(t *testing.T) {
 			var r repository.Repository
 
 			r.Brancher = &MockRepositoryBranch{
-				repo:       repo,
-				local:      tt.args.local,
-				remote:     tt.args.remote,
-				localRefs:  tt.args.localRefs,
-				remoteRefs: tt.args.remoteRefs,
-				tagRefs:    tt.args.tagRefs,
-				configErr:  tt.args.configErr,
-				headErr:    tt.args.headErr,
-				refsErr:    tt.args.refsErr,
+				repo:         repo,
+				local:        tt.args.local,
+				remote:       tt.args.remote,
+				url:          tt.args.url,
+				head:         tt.args.head,
+				upstream:     tt.args.upstream,
+				detached:     tt.args.detached,
+				referenceErr: tt.args.referenceErr,
+				localRefs:    tt.args.localRefs,
+				remoteRefs:   tt.args.remoteRefs,
+				tagRefs:      tt.args.tagRefs,
+				configErr:    tt.args.configErr,
+				headErr:      tt.args.headErr,
+				refsErr:      tt.args.refsErr,
 			}
 
 			branch, err := r.Branch()
@@ -393,7 +585,152 @@ func TestBranch(t *testing.T) {
 
 			assert.Equal(t, tt.want.local, branch.Local)
 			assert.Equal(t, tt.want.remote, branch.Remote)
+			assert.Equal(t, tt.want.url, branch.URL)
+			assert.Equal(t, tt.want.detached, branch.Detached)
+			assert.Equal(t, tt.want.ahead, branch.Ahead)
+			assert.Equal(t, tt.want.behind, branch.Behind)
 			assert.Equal(t, tt.want.refs, branch.Refs)
 		})
 	}
 }
+
+func TestCreateBranch(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		name   string
+		runErr error
+	}
+
+	type want struct {
+		cmd  string
+		args []string
+		err  string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "branch",
+			args: args{
+				name: "fix-crash",
+			},
+			want: want{
+				cmd:  "git",
+				args: []string{"switch", "--create", "fix-crash"},
+			},
+		},
+		{
+			name: "error",
+			args: args{
+				name:   "fix-crash",
+				runErr: errMockBranch,
+			},
+			want: want{
+				err: "unable to run command: error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			shell := MockShell{
+				err: tt.args.runErr,
+			}
+
+			repo := repository.Repository{
+				Runner: shell.Run(),
+			}
+
+			err := repo.CreateBranch(tt.args.name, io.Discard)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want.cmd, shell.command)
+			assert.Equal(t, tt.want.args, shell.args)
+		})
+	}
+}
+
+func TestRevertBranch(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		name   string
+		runErr error
+	}
+
+	type want struct {
+		cmds [][]string
+		err  string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "branch",
+			args: args{
+				name: "fix-crash",
+			},
+			want: want{
+				cmds: [][]string{
+					{"git", "checkout", "-"},
+					{"git", "branch", "--delete", "fix-crash"},
+				},
+			},
+		},
+		{
+			name: "error",
+			args: args{
+				name:   "fix-crash",
+				runErr: errMockBranch,
+			},
+			want: want{
+				cmds: [][]string{
+					{"git", "checkout", "-"},
+				},
+				err: "unable to run command: error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var cmds [][]string
+
+			repo := repository.Repository{
+				Runner: func(_ io.Writer, command string, args []string, _ []string) error {
+					cmds = append(cmds, append([]string{command}, args...))
+
+					return tt.args.runErr
+				},
+			}
+
+			err := repo.RevertBranch(tt.args.name, io.Discard)
+			assert.Equal(t, tt.want.cmds, cmds)
+
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
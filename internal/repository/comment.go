@@ -0,0 +1,59 @@
+package repository
+
+import (
+	"fmt"
+
+	"github.com/go-git/go-git/v5/config"
+)
+
+// DefaultCommentChar starts comment lines when the config does not set one.
+const DefaultCommentChar = "#"
+
+// CommentChar returns the character that starts comment lines of the message.
+// The value "auto" is returned unchanged as it depends on the message.
+func (r *Repository) CommentChar() (string, error) {
+	c, err := r.option("core", "commentChar")
+	if err != nil {
+		return "", err
+	}
+
+	if c == "" {
+		return DefaultCommentChar, nil
+	}
+
+	return c, nil
+}
+
+// Cleanup returns how Git cleans up the message. An empty value is the
+// default mode.
+func (r *Repository) Cleanup() (string, error) {
+	return r.option("commit", "cleanup")
+}
+
+// option returns the value of the option. The repository config takes
+// precedence over the global config.
+func (r *Repository) option(section, key string) (string, error) {
+	cfg, err := r.Configer.Config()
+	if err != nil {
+		return "", fmt.Errorf("unable to get repository config: %w", err)
+	}
+
+	if v := rawOption(cfg, section, key); v != "" {
+		return v, nil
+	}
+
+	cfg, err = r.GlobalConfig(config.GlobalScope)
+	if err != nil {
+		return "", fmt.Errorf("unable to get global config: %w", err)
+	}
+
+	return rawOption(cfg, section, key), nil
+}
+
+func rawOption(cfg *config.Config, section, key string) string {
+	if cfg == nil || cfg.Raw == nil {
+		return ""
+	}
+
+	return cfg.Raw.Section(section).Option(key)
+}
@@ -0,0 +1,193 @@
+package repository_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/go-git/go-git/v5/config"
+	"github.com/stretchr/testify/assert"
+)
+
+type MockRepositoryComment struct {
+	char    string
+	cleanup string
+	err     error
+}
+
+func (m MockRepositoryComment) Config() (*config.Config, error) {
+	return commentConfig(m.char, m.cleanup), m.err
+}
+
+func MockGlobalComment(char, cleanup string, err error) func(scope config.Scope) (*config.Config, error) {
+	return func(scope config.Scope) (*config.Config, error) {
+		return commentConfig(char, cleanup), err
+	}
+}
+
+func commentConfig(char, cleanup string) *config.Config {
+	cfg := config.NewConfig()
+
+	if char != "" {
+		cfg.Raw.Section("core").SetOption("commentChar", char)
+	}
+
+	if cleanup != "" {
+		cfg.Raw.Section("commit").SetOption("cleanup", cleanup)
+	}
+
+	return cfg
+}
+
+var errMockComment = errors.New("error")
+
+func TestCommentChar(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		local     string
+		global    string
+		localErr  error
+		globalErr error
+	}
+
+	type want struct {
+		char string
+		err  string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "default",
+			want: want{char: "#"},
+		},
+		{
+			name: "repository",
+			args: args{local: ";"},
+			want: want{char: ";"},
+		},
+		{
+			name: "global",
+			args: args{global: "%"},
+			want: want{char: "%"},
+		},
+		{
+			name: "repository_global",
+			args: args{local: ";", global: "%"},
+			want: want{char: ";"},
+		},
+		{
+			name: "auto",
+			args: args{local: "auto"},
+			want: want{char: "auto"},
+		},
+		{
+			name: "repository_error",
+			args: args{localErr: errMockComment},
+			want: want{err: "unable to get repository config: error"},
+		},
+		{
+			name: "global_error",
+			args: args{globalErr: errMockComment},
+			want: want{err: "unable to get global config: error"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := repository.Repository{
+				Configer:     MockRepositoryComment{char: tt.args.local, err: tt.args.localErr},
+				GlobalConfig: MockGlobalComment(tt.args.global, "", tt.args.globalErr),
+			}
+
+			c, err := r.CommentChar()
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.char, c)
+		})
+	}
+}
+
+func TestCleanup(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		local     string
+		global    string
+		localErr  error
+		globalErr error
+	}
+
+	type want struct {
+		cleanup string
+		err     string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "default",
+		},
+		{
+			name: "repository",
+			args: args{local: "verbatim"},
+			want: want{cleanup: "verbatim"},
+		},
+		{
+			name: "global",
+			args: args{global: "scissors"},
+			want: want{cleanup: "scissors"},
+		},
+		{
+			name: "repository_global",
+			args: args{local: "whitespace", global: "scissors"},
+			want: want{cleanup: "whitespace"},
+		},
+		{
+			name: "repository_error",
+			args: args{localErr: errMockComment},
+			want: want{err: "unable to get repository config: error"},
+		},
+		{
+			name: "global_error",
+			args: args{globalErr: errMockComment},
+			want: want{err: "unable to get global config: error"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			r := repository.Repository{
+				Configer:     MockRepositoryComment{cleanup: tt.args.local, err: tt.args.localErr},
+				GlobalConfig: MockGlobalComment("", tt.args.global, tt.args.globalErr),
+			}
+
+			c, err := r.Cleanup()
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want.cleanup, c)
+		})
+	}
+}
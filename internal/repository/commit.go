@@ -4,17 +4,25 @@ import (
 	"fmt"
 	"io"
 	"os"
+	"time"
 )
 
 type Commit struct {
-	Author      string
-	Subject     string
-	Body        string
-	Footer      string
-	Amend       bool
-	DryRun      bool
-	File        bool
-	MessageFile string
+	Author        string
+	Date          time.Time
+	Committer     User
+	CommitterDate time.Time
+	Subject       string
+	Body          string
+	Footer        string
+	Amend         bool
+	AllowEmpty    bool
+	NoHooks       bool
+	DryRun        bool
+	File          bool
+	MessageFile   string
+	Comments      string
+	Output        io.Writer
 }
 
 const command = "git"
@@ -24,7 +32,12 @@ func (r *Repository) Apply(c Commit) error {
 		return r.file(c)
 	}
 
-	if err := r.Runner(os.Stdout, command, build(c)); err != nil {
+	out := c.Output
+	if out == nil {
+		out = os.Stdout
+	}
+
+	if err := r.Runner(out, command, build(c), env(c)); err != nil {
 		return fmt.Errorf("unable to run command: %w", err)
 	}
 
@@ -48,9 +61,18 @@ func (r *Repository) file(c Commit) error {
 func build(c Commit) []string {
 	var args []string
 
+	// Hooks are disabled for commits whose message has already been written.
+	if c.NoHooks {
+		args = append(args, "-c", "core.hooksPath="+os.DevNull)
+	}
+
 	args = append(args, "commit")
 	args = append(args, "--author", c.Author)
 
+	if !c.Date.IsZero() {
+		args = append(args, "--date", c.Date.Format(time.RFC3339))
+	}
+
 	if c.Subject != "" {
 		args = append(args, "--message", c.Subject)
 	}
@@ -71,9 +93,32 @@ func build(c Commit) []string {
 		args = append(args, "--amend")
 	}
 
+	if c.AllowEmpty {
+		args = append(args, "--allow-empty")
+	}
+
 	return args
 }
 
+// env overrides the committer which Git only reads from the environment.
+func env(c Commit) []string {
+	var vars []string
+
+	if c.Committer.Name != "" {
+		vars = append(vars, "GIT_COMMITTER_NAME="+c.Committer.Name)
+	}
+
+	if c.Committer.Email != "" {
+		vars = append(vars, "GIT_COMMITTER_EMAIL="+c.Committer.Email)
+	}
+
+	if !c.CommitterDate.IsZero() {
+		vars = append(vars, "GIT_COMMITTER_DATE="+c.CommitterDate.Format(time.RFC3339))
+	}
+
+	return vars
+}
+
 func write(c Commit, w io.WriteCloser) error {
 	var err error
 
@@ -91,6 +136,15 @@ func write(c Commit, w io.WriteCloser) error {
 		fmt.Fprintln(w, c.Footer)
 	}
 
+	// Comments are only written to the message file which Git cleans up.
+	if c.Comments != "" {
+		if c.Footer != "" {
+			fmt.Fprintln(w, "")
+		}
+
+		fmt.Fprintln(w, c.Comments)
+	}
+
 	if err = w.Close(); err != nil {
 		return fmt.Errorf("unable to close file: %w", err)
 	}
@@ -6,6 +6,7 @@ import (
 	"os"
 	"strings"
 	"testing"
+	"time"
 
 	"github.com/mikelorant/committed/internal/repository"
 
@@ -15,14 +16,16 @@ import (
 type MockShell struct {
 	command string
 	args    []string
+	env     []string
 
 	err error
 }
 
-func (r *MockShell) Run() func(w io.Writer, command string, args []string) error {
-	return func(w io.Writer, command string, args []string) error {
+func (r *MockShell) Run() func(w io.Writer, command string, args []string, env []string) error {
+	return func(w io.Writer, command string, args []string, env []string) error {
 		r.command = command
 		r.args = args
+		r.env = env
 
 		if r.err != nil {
 			return r.err
@@ -75,6 +78,7 @@ func TestApply(t *testing.T) {
 	type want struct {
 		cmd          string
 		args         []string
+		env          []string
 		data         string
 		mockFilename string
 		err          string
@@ -106,6 +110,35 @@ func TestApply(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "identity",
+			args: args{
+				commit: repository.Commit{
+					Author:  "John Doe <john.doe@example.com>",
+					Date:    time.Date(2022, time.January, 1, 1, 0, 0, 0, time.UTC),
+					Subject: "summary",
+					Committer: repository.User{
+						Name:  "Jane Doe",
+						Email: "jane.doe@example.com",
+					},
+					CommitterDate: time.Date(2022, time.January, 2, 1, 0, 0, 0, time.FixedZone("", 36000)),
+				},
+			},
+			want: want{
+				cmd: "git",
+				args: []string{
+					"commit",
+					"--author", "John Doe <john.doe@example.com>",
+					"--date", "2022-01-01T01:00:00Z",
+					"--message", "summary",
+				},
+				env: []string{
+					"GIT_COMMITTER_NAME=Jane Doe",
+					"GIT_COMMITTER_EMAIL=jane.doe@example.com",
+					"GIT_COMMITTER_DATE=2022-01-02T01:00:00+10:00",
+				},
+			},
+		},
 		{
 			name: "no_body",
 			args: args{
@@ -163,6 +196,46 @@ func TestApply(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "allow_empty",
+			args: args{
+				commit: repository.Commit{
+					Author:     "John Doe <john.doe@example.com",
+					Subject:    "amend! summary",
+					AllowEmpty: true,
+				},
+			},
+			want: want{
+				cmd: "git",
+				args: []string{
+					"commit",
+					"--author", "John Doe <john.doe@example.com",
+					"--message", "amend! summary",
+					"--allow-empty",
+				},
+			},
+		},
+		{
+			name: "no_hooks",
+			args: args{
+				commit: repository.Commit{
+					Author:     "John Doe <john.doe@example.com",
+					Subject:    "amend! summary",
+					AllowEmpty: true,
+					NoHooks:    true,
+				},
+			},
+			want: want{
+				cmd: "git",
+				args: []string{
+					"-c", "core.hooksPath=" + os.DevNull,
+					"commit",
+					"--author", "John Doe <john.doe@example.com",
+					"--message", "amend! summary",
+					"--allow-empty",
+				},
+			},
+		},
 		{
 			name: "dryrun",
 			args: args{
@@ -249,6 +322,35 @@ func TestApply(t *testing.T) {
 				data:         "summary\n\nbody\n\nSigned-off-by: John Doe <john.doe@example.com>",
 			},
 		},
+		{
+			name: "file_summary_comments",
+			args: args{
+				commit: repository.Commit{
+					Subject:     "summary",
+					Comments:    "# comment\n# ------------------------ >8 ------------------------\ndiff",
+					MessageFile: "test",
+				},
+			},
+			want: want{
+				mockFilename: "test",
+				data:         "summary\n\n# comment\n# ------------------------ >8 ------------------------\ndiff",
+			},
+		},
+		{
+			name: "file_summary_footer_comments",
+			args: args{
+				commit: repository.Commit{
+					Subject:     "summary",
+					Footer:      "Signed-off-by: John Doe <john.doe@example.com>",
+					Comments:    "# comment",
+					MessageFile: "test",
+				},
+			},
+			want: want{
+				mockFilename: "test",
+				data:         "summary\n\nSigned-off-by: John Doe <john.doe@example.com>\n\n# comment",
+			},
+		},
 		{
 			name: "run_error",
 			args: args{
@@ -315,6 +417,7 @@ func TestApply(t *testing.T) {
 
 			assert.Equal(t, tt.want.cmd, shell.command, "command")
 			assert.Equal(t, tt.want.args, shell.args, "args")
+			assert.Equal(t, tt.want.env, shell.env, "env")
 
 			if tt.args.commit.MessageFile != "" {
 				out, _ := os.ReadFile(openFile.mockFilename)
@@ -2,16 +2,19 @@ package repository
 
 import (
 	"fmt"
+	"sort"
+	"strings"
 	"time"
 
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
 )
 
 type Head struct {
-	Hash    string
-	Author  User
-	When    time.Time
-	Message string
+	Hash    string    `json:"hash"`
+	Author  User      `json:"author"`
+	When    time.Time `json:"when"`
+	Message string    `json:"message"`
 }
 
 func (r *Repository) Head() (Head, error) {
@@ -30,6 +33,197 @@ func (r *Repository) Head() (Head, error) {
 		return Head{}, fmt.Errorf("unable to get head commit: %w", err)
 	}
 
+	return toHead(o), nil
+}
+
+// Lookup returns the commit of the revision.
+func (r *Repository) Lookup(rev string) (Head, error) {
+	h, err := r.Header.ResolveRevision(plumbing.Revision(rev))
+	if err != nil {
+		return Head{}, fmt.Errorf("unable to resolve revision: %v: %w", rev, err)
+	}
+
+	o, err := r.Header.CommitObject(*h)
+	if err != nil {
+		return Head{}, fmt.Errorf("unable to get commit: %v: %w", rev, err)
+	}
+
+	return toHead(o), nil
+}
+
+// History returns the most recent commits of the current branch following the
+// first parent. A repository without commits has no history.
+func (r *Repository) History(n int) ([]Head, error) {
+	h, err := r.Header.Head()
+
+	switch {
+	case err == nil:
+	case err.Error() == plumbing.ErrReferenceNotFound.Error():
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unable to get head reference: %w", err)
+	}
+
+	var hs []Head
+
+	hash := h.Hash()
+
+	for len(hs) < n {
+		o, err := r.Header.CommitObject(hash)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get commit: %v: %w", hash, err)
+		}
+
+		hs = append(hs, toHead(o))
+
+		if len(o.ParentHashes) == 0 {
+			break
+		}
+
+		hash = o.ParentHashes[0]
+	}
+
+	return hs, nil
+}
+
+// Log returns the commits of the revision range, newest first. A range in the
+// format "from..to" includes the commits reachable from to that are not
+// reachable from from while a single revision includes every commit to the
+// root. Commits of merged branches are included in both.
+func (r *Repository) Log(rev string) ([]Head, error) {
+	from, to, isRange := strings.Cut(rev, "..")
+	if !isRange {
+		from, to = "", rev
+	}
+
+	if to == "" {
+		to = "HEAD"
+	}
+
+	exclude := make(map[plumbing.Hash]bool)
+
+	if isRange {
+		if from == "" {
+			from = "HEAD"
+		}
+
+		h, err := r.Header.ResolveRevision(plumbing.Revision(from))
+		if err != nil {
+			return nil, fmt.Errorf("unable to resolve revision: %v: %w", from, err)
+		}
+
+		if err := r.ancestors(*h, exclude, func(*object.Commit) {}); err != nil {
+			return nil, err
+		}
+	}
+
+	h, err := r.Header.ResolveRevision(plumbing.Revision(to))
+	if err != nil {
+		return nil, fmt.Errorf("unable to resolve revision: %v: %w", to, err)
+	}
+
+	var cs []*object.Commit
+
+	err = r.ancestors(*h, exclude, func(o *object.Commit) {
+		cs = append(cs, o)
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Commits are ordered by commit date the same as git log.
+	sort.SliceStable(cs, func(i, j int) bool {
+		return cs[i].Committer.When.After(cs[j].Committer.When)
+	})
+
+	hs := make([]Head, len(cs))
+	for i, o := range cs {
+		hs[i] = toHead(o)
+	}
+
+	return hs, nil
+}
+
+// Unpushed returns the commits of the head that are not on any
+// remote-tracking branch, newest first. Every commit is unpushed when there
+// are no remote-tracking branches.
+func (r *Repository) Unpushed() ([]Head, error) {
+	h, err := r.Brancher.Head()
+
+	switch {
+	case err == nil:
+	case err.Error() == plumbing.ErrReferenceNotFound.Error():
+		return nil, nil
+	default:
+		return nil, fmt.Errorf("unable to get head reference: %w", err)
+	}
+
+	hashes, err := unpushed(r.Brancher, h.Hash())
+	if err != nil {
+		return nil, err
+	}
+
+	cs := make([]*object.Commit, len(hashes))
+
+	for i, hash := range hashes {
+		o, err := r.Brancher.CommitObject(hash)
+		if err != nil {
+			return nil, fmt.Errorf("unable to get commit: %v: %w", hash, err)
+		}
+
+		cs[i] = o
+	}
+
+	// Commits are ordered by commit date the same as git log. The walk is
+	// unordered so commits with the same date are ordered by hash.
+	sort.Slice(cs, func(i, j int) bool {
+		if !cs[i].Committer.When.Equal(cs[j].Committer.When) {
+			return cs[i].Committer.When.After(cs[j].Committer.When)
+		}
+
+		return cs[i].Hash.String() < cs[j].Hash.String()
+	})
+
+	hs := make([]Head, len(cs))
+	for i, o := range cs {
+		hs[i] = toHead(o)
+	}
+
+	return hs, nil
+}
+
+// ancestors calls the function with the commit of the hash and every commit
+// reachable from it through any parent. Commits already seen are skipped along
+// with their parents and every commit visited is added to seen.
+func (r *Repository) ancestors(hash plumbing.Hash, seen map[plumbing.Hash]bool, fn func(*object.Commit)) error {
+	stack := []plumbing.Hash{hash}
+
+	for len(stack) > 0 {
+		hash, stack = stack[len(stack)-1], stack[:len(stack)-1]
+
+		if seen[hash] {
+			continue
+		}
+
+		seen[hash] = true
+
+		o, err := r.Header.CommitObject(hash)
+		if err != nil {
+			return fmt.Errorf("unable to get commit: %v: %w", hash, err)
+		}
+
+		fn(o)
+
+		// Parents are pushed in reverse so the first parent is visited first.
+		for i := len(o.ParentHashes) - 1; i >= 0; i-- {
+			stack = append(stack, o.ParentHashes[i])
+		}
+	}
+
+	return nil
+}
+
+func toHead(o *object.Commit) Head {
 	return Head{
 		Hash: o.Hash.String(),
 		Author: User{
@@ -38,5 +232,5 @@ func (r *Repository) Head() (Head, error) {
 		},
 		When:    o.Author.When,
 		Message: o.Message,
-	}, nil
+	}
 }
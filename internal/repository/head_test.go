@@ -7,8 +7,13 @@ import (
 
 	"github.com/mikelorant/committed/internal/repository"
 
+	"github.com/go-git/go-billy/v5/memfs"
+	fixtures "github.com/go-git/go-git-fixtures/v5"
+	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/cache"
 	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/go-git/go-git/v5/storage/filesystem"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -16,6 +21,7 @@ type MockRepositoryHead struct {
 	head            repository.Head
 	headErr         error
 	commitObjectErr error
+	resolveErr      error
 }
 
 func (m MockRepositoryHead) Head() (*plumbing.Reference, error) {
@@ -36,6 +42,16 @@ func (m MockRepositoryHead) CommitObject(h plumbing.Hash) (*object.Commit, error
 	}, m.commitObjectErr
 }
 
+func (m MockRepositoryHead) ResolveRevision(rev plumbing.Revision) (*plumbing.Hash, error) {
+	if m.resolveErr != nil {
+		return nil, m.resolveErr
+	}
+
+	h := plumbing.NewHash(m.head.Hash)
+
+	return &h, nil
+}
+
 var errMockHead = errors.New("error")
 
 var mockTime = time.Date(2022, time.January, 1, 1, 0, 0, 0, time.UTC)
@@ -151,3 +167,524 @@ func TestHead(t *testing.T) {
 		})
 	}
 }
+
+func TestLookup(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		head            repository.Head
+		resolveErr      error
+		commitObjectErr error
+	}
+
+	type want struct {
+		head repository.Head
+		err  string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "commit",
+			args: args{
+				head: repository.Head{
+					Hash: "1234567890abcdef1234567890abcdef12345678",
+					Author: repository.User{
+						Name:  "John Doe",
+						Email: "john.doe@example.com",
+					},
+					When:    mockTime,
+					Message: "message",
+				},
+			},
+			want: want{
+				head: repository.Head{
+					Hash: "1234567890abcdef1234567890abcdef12345678",
+					Author: repository.User{
+						Name:  "John Doe",
+						Email: "john.doe@example.com",
+					},
+					When:    mockTime,
+					Message: "message",
+				},
+			},
+		},
+		{
+			name: "resolve_error",
+			args: args{
+				resolveErr: errMockHead,
+			},
+			want: want{
+				err: "unable to resolve revision: 1234567: error",
+			},
+		},
+		{
+			name: "commit_object_error",
+			args: args{
+				commitObjectErr: errMockHead,
+			},
+			want: want{
+				err: "unable to get commit: 1234567: error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var r repository.Repository
+
+			r.Header = MockRepositoryHead{
+				head:            tt.args.head,
+				resolveErr:      tt.args.resolveErr,
+				commitObjectErr: tt.args.commitObjectErr,
+			}
+
+			h, err := r.Lookup("1234567")
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			assert.Equal(t, tt.want.head, h)
+		})
+	}
+}
+
+func TestHistory(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		limit           int
+		headErr         error
+		commitObjectErr error
+	}
+
+	type want struct {
+		hashes []string
+		err    string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "limit",
+			args: args{
+				limit: 3,
+			},
+			want: want{
+				hashes: []string{
+					"6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+					"918c48b83bd081e863dbe1b80f8998f058cd8294",
+					"af2d6a6954d532f8ffb47615169c8fdf9d383a1a",
+				},
+			},
+		},
+		{
+			name: "first_parent",
+			args: args{
+				limit: 10,
+			},
+			want: want{
+				hashes: []string{
+					"6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+					"918c48b83bd081e863dbe1b80f8998f058cd8294",
+					"af2d6a6954d532f8ffb47615169c8fdf9d383a1a",
+					"1669dce138d9b841a518c64b10914d88f5e488ea",
+					"35e85108805c84807bc66a02d91535e1e24b38b9",
+					"b029517f6300c2da0f4b651b8642506cd6aaf45d",
+				},
+			},
+		},
+		{
+			name: "head_reference_not_found",
+			args: args{
+				limit:   10,
+				headErr: plumbing.ErrReferenceNotFound,
+			},
+		},
+		{
+			name: "head_error",
+			args: args{
+				limit:   10,
+				headErr: errMockHead,
+			},
+			want: want{
+				err: "unable to get head reference: error",
+			},
+		},
+		{
+			name: "commit_object_error",
+			args: args{
+				limit:           10,
+				commitObjectErr: errMockHead,
+			},
+			want: want{
+				err: "unable to get commit: 0000000000000000000000000000000000000000: error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var r repository.Repository
+
+			switch {
+			case tt.args.headErr != nil || tt.args.commitObjectErr != nil:
+				r.Header = MockRepositoryHead{
+					headErr:         tt.args.headErr,
+					commitObjectErr: tt.args.commitObjectErr,
+				}
+			default:
+				dotgit := fixtures.Basic().One().DotGit()
+				st := filesystem.NewStorage(dotgit, cache.NewObjectLRUDefault())
+				repo, _ := git.Open(st, memfs.New())
+
+				r.Header = repo
+			}
+
+			hs, err := r.History(tt.args.limit)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			var hashes []string
+			for _, h := range hs {
+				hashes = append(hashes, h.Hash)
+			}
+
+			assert.Equal(t, tt.want.hashes, hashes)
+		})
+	}
+}
+
+func TestLog(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		rev             string
+		resolveErr      error
+		commitObjectErr error
+	}
+
+	type want struct {
+		hashes []string
+		err    string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "range",
+			args: args{
+				rev: "af2d6a6954d532f8ffb47615169c8fdf9d383a1a..HEAD",
+			},
+			want: want{
+				hashes: []string{
+					"6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+					"918c48b83bd081e863dbe1b80f8998f058cd8294",
+				},
+			},
+		},
+		{
+			name: "range_head",
+			args: args{
+				rev: "HEAD~2..",
+			},
+			want: want{
+				hashes: []string{
+					"6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+					"918c48b83bd081e863dbe1b80f8998f058cd8294",
+				},
+			},
+		},
+		{
+			name: "range_merge",
+			args: args{
+				rev: "b029517f6300c2da0f4b651b8642506cd6aaf45d..1669dce138d9b841a518c64b10914d88f5e488ea",
+			},
+			want: want{
+				hashes: []string{
+					"1669dce138d9b841a518c64b10914d88f5e488ea",
+					"a5b8b09e2f8fcb0bb99d3ccb0958157b40890d69",
+					"35e85108805c84807bc66a02d91535e1e24b38b9",
+					"b8e471f58bcbca63b07bda20e428190409c2db47",
+				},
+			},
+		},
+		{
+			name: "range_merged_branch",
+			args: args{
+				rev: "35e85108805c84807bc66a02d91535e1e24b38b9..1669dce138d9b841a518c64b10914d88f5e488ea",
+			},
+			want: want{
+				hashes: []string{
+					"1669dce138d9b841a518c64b10914d88f5e488ea",
+					"a5b8b09e2f8fcb0bb99d3ccb0958157b40890d69",
+					"b8e471f58bcbca63b07bda20e428190409c2db47",
+				},
+			},
+		},
+		{
+			name: "range_empty",
+			args: args{
+				rev: "HEAD..HEAD",
+			},
+		},
+		{
+			name: "revision",
+			args: args{
+				rev: "918c48b83bd081e863dbe1b80f8998f058cd8294",
+			},
+			want: want{
+				hashes: []string{
+					"918c48b83bd081e863dbe1b80f8998f058cd8294",
+					"af2d6a6954d532f8ffb47615169c8fdf9d383a1a",
+					"1669dce138d9b841a518c64b10914d88f5e488ea",
+					"a5b8b09e2f8fcb0bb99d3ccb0958157b40890d69",
+					"35e85108805c84807bc66a02d91535e1e24b38b9",
+					"b8e471f58bcbca63b07bda20e428190409c2db47",
+					"b029517f6300c2da0f4b651b8642506cd6aaf45d",
+				},
+			},
+		},
+		{
+			name: "resolve_error",
+			args: args{
+				rev:        "HEAD",
+				resolveErr: errMockHead,
+			},
+			want: want{
+				err: "unable to resolve revision: HEAD: error",
+			},
+		},
+		{
+			name: "commit_object_error",
+			args: args{
+				rev:             "HEAD",
+				commitObjectErr: errMockHead,
+			},
+			want: want{
+				err: "unable to get commit: 0000000000000000000000000000000000000000: error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var r repository.Repository
+
+			switch {
+			case tt.args.resolveErr != nil || tt.args.commitObjectErr != nil:
+				r.Header = MockRepositoryHead{
+					resolveErr:      tt.args.resolveErr,
+					commitObjectErr: tt.args.commitObjectErr,
+				}
+			default:
+				dotgit := fixtures.Basic().One().DotGit()
+				st := filesystem.NewStorage(dotgit, cache.NewObjectLRUDefault())
+				repo, _ := git.Open(st, memfs.New())
+
+				r.Header = repo
+			}
+
+			hs, err := r.Log(tt.args.rev)
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+
+			var hashes []string
+			for _, h := range hs {
+				hashes = append(hashes, h.Hash)
+			}
+
+			assert.Equal(t, tt.want.hashes, hashes)
+		})
+	}
+}
+
+func TestUnpushed(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		remote   string
+		upstream string
+		remotes  map[string]string
+		detached bool
+		headErr  error
+		refsErr  error
+	}
+
+	type want struct {
+		count  int
+		hashes []string
+		err    string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "upstream",
+			args: args{
+				remote:   "origin",
+				upstream: "918c48b83bd081e863dbe1b80f8998f058cd8294",
+			},
+			want: want{
+				count:  1,
+				hashes: []string{"6ecf0ef2c2dffb796033e5a02219af86ec6584e5"},
+			},
+		},
+		{
+			name: "pushed",
+			args: args{
+				remote:   "origin",
+				upstream: "6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+			},
+		},
+		{
+			name: "no_upstream",
+			want: want{
+				count: 8,
+			},
+		},
+		{
+			name: "upstream_not_fetched",
+			args: args{
+				remote: "origin",
+			},
+			want: want{
+				count: 8,
+			},
+		},
+		{
+			name: "detached",
+			args: args{
+				remote:   "origin",
+				upstream: "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				detached: true,
+			},
+			want: want{
+				count:  1,
+				hashes: []string{"6ecf0ef2c2dffb796033e5a02219af86ec6584e5"},
+			},
+		},
+		{
+			name: "no_upstream_remote",
+			args: args{
+				remotes: map[string]string{
+					"origin/feature": "af2d6a6954d532f8ffb47615169c8fdf9d383a1a",
+				},
+			},
+			want: want{
+				count: 2,
+				hashes: []string{
+					"6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+					"918c48b83bd081e863dbe1b80f8998f058cd8294",
+				},
+			},
+		},
+		{
+			name: "other_remote",
+			args: args{
+				remote:   "origin",
+				upstream: "af2d6a6954d532f8ffb47615169c8fdf9d383a1a",
+				remotes: map[string]string{
+					"fork/master": "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				},
+			},
+			want: want{
+				count:  1,
+				hashes: []string{"6ecf0ef2c2dffb796033e5a02219af86ec6584e5"},
+			},
+		},
+		{
+			name: "references_error",
+			args: args{
+				refsErr: errMockBranch,
+			},
+			want: want{
+				err: "unable to get references: error",
+			},
+		},
+		{
+			name: "head_error",
+			args: args{
+				headErr: errMockBranch,
+			},
+			want: want{
+				err: "unable to get head reference: error",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dotgit := fixtures.Basic().One().DotGit()
+			st := filesystem.NewStorage(dotgit, cache.NewObjectLRUDefault())
+			repo, _ := git.Open(st, memfs.New())
+
+			r := repository.Repository{
+				Header: repo,
+				Brancher: &MockRepositoryBranch{
+					repo:     repo,
+					local:    "master",
+					remote:   tt.args.remote,
+					upstream: tt.args.upstream,
+					remotes:  tt.args.remotes,
+					detached: tt.args.detached,
+					headErr:  tt.args.headErr,
+					refsErr:  tt.args.refsErr,
+				},
+			}
+
+			hs, err := r.Unpushed()
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+			assert.Len(t, hs, tt.want.count)
+
+			if tt.want.hashes != nil {
+				var hashes []string
+				for _, h := range hs {
+					hashes = append(hashes, h.Hash)
+				}
+
+				assert.Equal(t, tt.want.hashes, hashes)
+			}
+		})
+	}
+}
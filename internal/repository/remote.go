@@ -18,3 +18,21 @@ func (r *Repository) Remotes() ([]string, error) {
 
 	return remotes, nil
 }
+
+// RemoteURLs returns the first URL of each remote.
+func (r *Repository) RemoteURLs() ([]string, error) {
+	var urls []string
+
+	rs, err := r.Remoter.Remotes()
+	if err != nil {
+		return urls, fmt.Errorf("unable to list remotes: %w", err)
+	}
+
+	for _, r := range rs {
+		if us := r.Config().URLs; len(us) > 0 {
+			urls = append(urls, us[0])
+		}
+	}
+
+	return urls, nil
+}
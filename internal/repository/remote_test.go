@@ -112,3 +112,98 @@ func TestRemote(t *testing.T) {
 		})
 	}
 }
+
+func TestRemoteURLs(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		remotes [][]string
+		err     error
+	}
+
+	type want struct {
+		urls []string
+		err  error
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "single",
+			args: args{
+				remotes: [][]string{{"git@github.com:acme/widget.git"}},
+			},
+			want: want{
+				urls: []string{"git@github.com:acme/widget.git"},
+			},
+		},
+		{
+			name: "multiple",
+			args: args{
+				remotes: [][]string{
+					{"git@github.com:john/widget.git"},
+					{"https://github.com/acme/widget.git", "git@github.com:acme/widget.git"},
+				},
+			},
+			want: want{
+				urls: []string{"git@github.com:john/widget.git", "https://github.com/acme/widget.git"},
+			},
+		},
+		{
+			name: "no_url",
+			args: args{
+				remotes: [][]string{{}},
+			},
+			want: want{
+				urls: nil,
+			},
+		},
+		{
+			name: "error",
+			args: args{
+				err: errMockUser,
+			},
+			want: want{
+				err: errMockUser,
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var r repository.Repository
+
+			m := memory.NewStorage()
+
+			rms := make([]*git.Remote, len(tt.args.remotes))
+			for i, v := range tt.args.remotes {
+				rc := config.RemoteConfig{
+					Name: "origin",
+					URLs: v,
+				}
+				rms[i] = git.NewRemote(m, &rc)
+			}
+
+			r.Remoter = MockRepositoryRemote{
+				remotes: rms,
+				err:     tt.args.err,
+			}
+
+			us, err := r.RemoteURLs()
+			if tt.want.err != nil {
+				assert.ErrorContains(t, err, tt.want.err.Error())
+				return
+			}
+			assert.Nil(t, err)
+
+			assert.Equal(t, tt.want.urls, us)
+		})
+	}
+}
@@ -26,12 +26,15 @@ type Remoter interface {
 type Header interface {
 	Head() (*plumbing.Reference, error)
 	CommitObject(h plumbing.Hash) (*object.Commit, error)
+	ResolveRevision(rev plumbing.Revision) (*plumbing.Hash, error)
 }
 
 type Brancher interface {
 	Configer
 	Head() (*plumbing.Reference, error)
+	Reference(plumbing.ReferenceName, bool) (*plumbing.Reference, error)
 	References() (storer.ReferenceIter, error)
+	CommitObject(plumbing.Hash) (*object.Commit, error)
 	TagObject(plumbing.Hash) (*object.Tag, error)
 }
 
@@ -41,7 +44,7 @@ type Worktreer interface {
 
 type Repository struct {
 	Opener       func(string, *git.PlainOpenOptions) (*git.Repository, error)
-	Runner       func(io.Writer, string, []string) error
+	Runner       func(io.Writer, string, []string, []string) error
 	OpenFiler    func(string, int, os.FileMode) (*os.File, error)
 	GlobalConfig func(config.Scope) (*config.Config, error)
 	Configer     Configer
@@ -52,21 +55,28 @@ type Repository struct {
 }
 
 type Description struct {
-	Users    []User
-	Remotes  []string
-	Head     Head
-	Branch   Branch
-	Worktree Worktree
+	Users       []User   `json:"users"`
+	Remotes     []string `json:"remotes"`
+	RemoteURLs  []string `json:"remoteURLs"`
+	Head        Head     `json:"head"`
+	History     []Head   `json:"history"`
+	Branch      Branch   `json:"branch"`
+	Worktree    Worktree `json:"worktree"`
+	CommentChar string   `json:"commentChar"`
+	Cleanup     string   `json:"cleanup"`
 }
 
 const repositoryPath string = "."
 
+// historyLimit is the number of recent commits described.
+const historyLimit = 10
+
 func New() *Repository {
 	return &Repository{
 		GlobalConfig: config.LoadConfig,
 		Opener:       git.PlainOpenWithOptions,
 		OpenFiler:    os.OpenFile,
-		Runner:       shell.Run,
+		Runner:       shell.RunEnv,
 	}
 }
 
@@ -104,11 +114,21 @@ func (r *Repository) Describe() (Description, error) {
 		return Description{}, fmt.Errorf("unable to get remotes: %w", err)
 	}
 
+	ru, err := r.RemoteURLs()
+	if err != nil {
+		return Description{}, fmt.Errorf("unable to get remote urls: %w", err)
+	}
+
 	h, err := r.Head()
 	if err != nil {
 		return Description{}, fmt.Errorf("unable to get head commit: %w", err)
 	}
 
+	hs, err := r.History(historyLimit)
+	if err != nil {
+		return Description{}, fmt.Errorf("unable to get history: %w", err)
+	}
+
 	b, err := r.Branch()
 	if err != nil {
 		return Description{}, fmt.Errorf("unable to get branch: %w", err)
@@ -119,11 +139,25 @@ func (r *Repository) Describe() (Description, error) {
 		return Description{}, fmt.Errorf("unable to get worktree: %w", err)
 	}
 
+	cc, err := r.CommentChar()
+	if err != nil {
+		return Description{}, fmt.Errorf("unable to get comment char: %w", err)
+	}
+
+	cl, err := r.Cleanup()
+	if err != nil {
+		return Description{}, fmt.Errorf("unable to get cleanup: %w", err)
+	}
+
 	return Description{
-		Users:    us,
-		Remotes:  rs,
-		Head:     h,
-		Branch:   b,
-		Worktree: wt,
+		Users:       us,
+		Remotes:     rs,
+		RemoteURLs:  ru,
+		Head:        h,
+		History:     hs,
+		Branch:      b,
+		Worktree:    wt,
+		CommentChar: cc,
+		Cleanup:     cl,
 	}, nil
 }
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/go-git/go-git/v5/plumbing"
+)
+
+// Reasons a commit can not be reworded by rebasing the current branch.
+var (
+	ErrRewordBranch = errors.New("commit is not on the current branch")
+	ErrRewordMerge  = errors.New("merge commits would be rebased")
+	ErrRewordFixup  = errors.New("fixup commits would be squashed")
+	ErrRewordPushed = errors.New("commit has been pushed")
+)
+
+// autosquashPrefixes are the subjects of commits moved by an autosquash
+// rebase.
+var autosquashPrefixes = []string{"fixup! ", "squash! ", "amend! "}
+
+// CheckReword checks the commit can be reworded by rebasing the current
+// branch. The commit must be in the first parent history of the head with no
+// merge or fixup commits since, and must not be on any remote-tracking branch.
+func (r *Repository) CheckReword(hash string) error {
+	h, err := r.Brancher.Head()
+	if err != nil {
+		return fmt.Errorf("unable to get head reference: %w", err)
+	}
+
+	target := plumbing.NewHash(hash)
+	next := h.Hash()
+
+	var distance int
+
+	for ; ; distance++ {
+		o, err := r.Brancher.CommitObject(next)
+		if err != nil {
+			return fmt.Errorf("unable to get commit: %v: %w", next, err)
+		}
+
+		if len(o.ParentHashes) > 1 {
+			return ErrRewordMerge
+		}
+
+		if o.Hash == target {
+			break
+		}
+
+		if isAutosquash(o.Message) {
+			return ErrRewordFixup
+		}
+
+		if len(o.ParentHashes) == 0 {
+			return ErrRewordBranch
+		}
+
+		next = o.ParentHashes[0]
+	}
+
+	p, err := pushed(r.Brancher, h, distance)
+	if err != nil {
+		return fmt.Errorf("unable to check remotes: %w", err)
+	}
+
+	if p {
+		return ErrRewordPushed
+	}
+
+	return nil
+}
+
+// Autosquash rebases the commits after the commit, squashing fixup commits
+// into their targets without prompting. Hooks are disabled as the messages
+// have already been written. A failed rebase is aborted so the branch is left
+// as it was.
+func (r *Repository) Autosquash(hash string, out io.Writer) error {
+	if out == nil {
+		out = os.Stdout
+	}
+
+	o, err := r.Header.CommitObject(plumbing.NewHash(hash))
+	if err != nil {
+		return fmt.Errorf("unable to get commit: %v: %w", hash, err)
+	}
+
+	args := []string{"-c", "core.hooksPath=" + os.DevNull, "rebase", "--interactive", "--autosquash"}
+
+	switch len(o.ParentHashes) {
+	case 0:
+		args = append(args, "--root")
+	default:
+		args = append(args, o.ParentHashes[0].String())
+	}
+
+	env := []string{"GIT_SEQUENCE_EDITOR=:"}
+
+	if err := r.Runner(out, command, args, env); err != nil {
+		if abortErr := r.Runner(out, command, []string{"rebase", "--abort"}, nil); abortErr != nil {
+			return fmt.Errorf("unable to run command: %w: rebase in progress, run git rebase --abort", err)
+		}
+
+		return fmt.Errorf("unable to run command: %w", err)
+	}
+
+	return nil
+}
+
+// pushed reports whether the commit the distance from the head is on a
+// remote-tracking branch. The history since is linear so the commit is pushed
+// when no more than the distance commits are unpushed.
+func pushed(b Brancher, h *plumbing.Reference, distance int) (bool, error) {
+	hs, err := unpushed(b, h.Hash())
+	if err != nil {
+		return false, err
+	}
+
+	return len(hs) <= distance, nil
+}
+
+func isAutosquash(msg string) bool {
+	for _, p := range autosquashPrefixes {
+		if strings.HasPrefix(msg, p) {
+			return true
+		}
+	}
+
+	return false
+}
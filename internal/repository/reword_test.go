@@ -0,0 +1,339 @@
+package repository_test
+
+import (
+	"io"
+	"os"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/go-git/go-billy/v5/memfs"
+	fixtures "github.com/go-git/go-git-fixtures/v5"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/cache"
+	"github.com/go-git/go-git/v5/storage/filesystem"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckReword(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		hash     string
+		local    string
+		remote   string
+		head     string
+		upstream string
+		remotes  map[string]string
+		detached bool
+		headErr  error
+		refsErr  error
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want string
+	}{
+		{
+			name: "head",
+			args: args{
+				hash:  "6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+				local: "master",
+			},
+		},
+		{
+			name: "parent",
+			args: args{
+				hash:  "af2d6a6954d532f8ffb47615169c8fdf9d383a1a",
+				local: "master",
+			},
+		},
+		{
+			name: "merge",
+			args: args{
+				hash:  "35e85108805c84807bc66a02d91535e1e24b38b9",
+				local: "master",
+			},
+			want: "merge commits would be rebased",
+		},
+		{
+			name: "not_on_branch",
+			args: args{
+				hash:  "6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+				local: "master",
+				head:  "35e85108805c84807bc66a02d91535e1e24b38b9",
+			},
+			want: "commit is not on the current branch",
+		},
+		{
+			name: "pushed",
+			args: args{
+				hash:     "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				local:    "master",
+				remote:   "origin",
+				upstream: "918c48b83bd081e863dbe1b80f8998f058cd8294",
+			},
+			want: "commit has been pushed",
+		},
+		{
+			name: "unpushed",
+			args: args{
+				hash:     "6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+				local:    "master",
+				remote:   "origin",
+				upstream: "918c48b83bd081e863dbe1b80f8998f058cd8294",
+			},
+		},
+		{
+			name: "upstream_not_fetched",
+			args: args{
+				hash:   "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				local:  "master",
+				remote: "origin",
+			},
+		},
+		{
+			name: "detached",
+			args: args{
+				hash:     "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				local:    "master",
+				remote:   "origin",
+				upstream: "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				detached: true,
+			},
+			want: "commit has been pushed",
+		},
+		{
+			name: "detached_unpushed",
+			args: args{
+				hash:     "6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+				local:    "master",
+				remote:   "origin",
+				upstream: "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				detached: true,
+			},
+		},
+		{
+			name: "no_upstream_pushed",
+			args: args{
+				hash:  "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				local: "feature",
+				remotes: map[string]string{
+					"origin/master": "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				},
+			},
+			want: "commit has been pushed",
+		},
+		{
+			name: "no_upstream_unpushed",
+			args: args{
+				hash:  "6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+				local: "feature",
+				remotes: map[string]string{
+					"origin/master": "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				},
+			},
+		},
+		{
+			name: "other_remote_pushed",
+			args: args{
+				hash:     "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				local:    "master",
+				remote:   "origin",
+				upstream: "af2d6a6954d532f8ffb47615169c8fdf9d383a1a",
+				remotes: map[string]string{
+					"fork/master": "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				},
+			},
+			want: "commit has been pushed",
+		},
+		{
+			name: "commit_error",
+			args: args{
+				hash:  "6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+				local: "master",
+				head:  "1234567890abcdef1234567890abcdef12345678",
+			},
+			want: "unable to get commit: 1234567890abcdef1234567890abcdef12345678: object not found",
+		},
+		{
+			name: "head_error",
+			args: args{
+				hash:    "6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+				local:   "master",
+				headErr: errMockBranch,
+			},
+			want: "unable to get head reference: error",
+		},
+		{
+			name: "references_error",
+			args: args{
+				hash:    "6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+				local:   "master",
+				refsErr: errMockBranch,
+			},
+			want: "unable to check remotes: unable to get references: error",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dotgit := fixtures.Basic().One().DotGit()
+			st := filesystem.NewStorage(dotgit, cache.NewObjectLRUDefault())
+			repo, _ := git.Open(st, memfs.New())
+
+			r := repository.Repository{
+				Brancher: &MockRepositoryBranch{
+					repo:     repo,
+					local:    tt.args.local,
+					remote:   tt.args.remote,
+					head:     tt.args.head,
+					upstream: tt.args.upstream,
+					remotes:  tt.args.remotes,
+					detached: tt.args.detached,
+					headErr:  tt.args.headErr,
+					refsErr:  tt.args.refsErr,
+				},
+			}
+
+			err := r.CheckReword(tt.args.hash)
+			if tt.want != "" {
+				assert.EqualError(t, err, tt.want)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
+
+func TestAutosquash(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		hash     string
+		runErr   error
+		abortErr error
+	}
+
+	type want struct {
+		cmds [][]string
+		err  string
+	}
+
+	rebase := func(base string) []string {
+		return []string{
+			"git", "-c", "core.hooksPath=" + os.DevNull,
+			"rebase", "--interactive", "--autosquash", base,
+		}
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "parent",
+			args: args{
+				hash: "918c48b83bd081e863dbe1b80f8998f058cd8294",
+			},
+			want: want{
+				cmds: [][]string{
+					rebase("af2d6a6954d532f8ffb47615169c8fdf9d383a1a"),
+				},
+			},
+		},
+		{
+			name: "root",
+			args: args{
+				hash: "b029517f6300c2da0f4b651b8642506cd6aaf45d",
+			},
+			want: want{
+				cmds: [][]string{
+					rebase("--root"),
+				},
+			},
+		},
+		{
+			name: "commit_error",
+			args: args{
+				hash: "1234567890abcdef1234567890abcdef12345678",
+			},
+			want: want{
+				err: "unable to get commit: 1234567890abcdef1234567890abcdef12345678: object not found",
+			},
+		},
+		{
+			name: "run_error",
+			args: args{
+				hash:   "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				runErr: errMockBranch,
+			},
+			want: want{
+				cmds: [][]string{
+					rebase("af2d6a6954d532f8ffb47615169c8fdf9d383a1a"),
+					{"git", "rebase", "--abort"},
+				},
+				err: "unable to run command: error",
+			},
+		},
+		{
+			name: "abort_error",
+			args: args{
+				hash:     "918c48b83bd081e863dbe1b80f8998f058cd8294",
+				runErr:   errMockBranch,
+				abortErr: errMockBranch,
+			},
+			want: want{
+				cmds: [][]string{
+					rebase("af2d6a6954d532f8ffb47615169c8fdf9d383a1a"),
+					{"git", "rebase", "--abort"},
+				},
+				err: "unable to run command: error: rebase in progress, run git rebase --abort",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			dotgit := fixtures.Basic().One().DotGit()
+			st := filesystem.NewStorage(dotgit, cache.NewObjectLRUDefault())
+			repo, _ := git.Open(st, memfs.New())
+
+			var cmds [][]string
+
+			r := repository.Repository{
+				Header: repo,
+				Runner: func(_ io.Writer, command string, args []string, env []string) error {
+					cmds = append(cmds, append([]string{command}, args...))
+
+					if len(cmds) > 1 {
+						return tt.args.abortErr
+					}
+
+					assert.Equal(t, []string{"GIT_SEQUENCE_EDITOR=:"}, env)
+
+					return tt.args.runErr
+				},
+			}
+
+			err := r.Autosquash(tt.args.hash, io.Discard)
+			assert.Equal(t, tt.want.cmds, cmds)
+
+			if tt.want.err != "" {
+				assert.EqualError(t, err, tt.want.err)
+				return
+			}
+			assert.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,196 @@
+package repository
+
+import (
+	"container/heap"
+	"errors"
+	"fmt"
+
+	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/object"
+)
+
+const (
+	sideLocal = 1 << iota
+	sideUpstream
+	sideBoth = sideLocal | sideUpstream
+)
+
+// tracking returns the number of commits ahead and behind the upstream. The
+// counts are zero when there is no upstream or it has not been fetched.
+func tracking(b Brancher, h *plumbing.Reference, up plumbing.ReferenceName) (int, int, error) {
+	if up == "" {
+		return 0, 0, nil
+	}
+
+	ref, err := b.Reference(up, true)
+	switch {
+	case err == nil:
+	case errors.Is(err, plumbing.ErrReferenceNotFound):
+		return 0, 0, nil
+	default:
+		return 0, 0, fmt.Errorf("unable to get upstream reference: %v: %w", up, err)
+	}
+
+	return aheadBehind(b, h.Hash(), ref.Hash())
+}
+
+// aheadBehind returns the number of commits only reachable from the local
+// commit and only reachable from the upstream commit.
+func aheadBehind(b Brancher, local, upstream plumbing.Hash) (int, int, error) {
+	if local == upstream {
+		return 0, 0, nil
+	}
+
+	sides, err := walkSides(b, local, []plumbing.Hash{upstream})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	var ahead, behind int
+
+	for _, side := range sides {
+		switch side {
+		case sideLocal:
+			ahead++
+		case sideUpstream:
+			behind++
+		}
+	}
+
+	return ahead, behind, nil
+}
+
+// walkSides walks the history of the local and upstream commits newest
+// first, marking each commit with the sides it is reachable from. The walk
+// stops once only commits reachable from both sides remain as their
+// ancestors are shared.
+func walkSides(b Brancher, local plumbing.Hash, upstreams []plumbing.Hash) (map[plumbing.Hash]int, error) {
+	sides := make(map[plumbing.Hash]int)
+	queue := &commitQueue{}
+
+	push := func(h plumbing.Hash, side int) error {
+		if sides[h]&side == side {
+			return nil
+		}
+
+		c, err := b.CommitObject(h)
+		if err != nil {
+			return fmt.Errorf("unable to get commit: %v: %w", h, err)
+		}
+
+		sides[h] |= side
+		heap.Push(queue, c)
+
+		return nil
+	}
+
+	if err := push(local, sideLocal); err != nil {
+		return nil, err
+	}
+
+	for _, u := range upstreams {
+		if err := push(u, sideUpstream); err != nil {
+			return nil, err
+		}
+	}
+
+	for queue.Len() > 0 && !queue.shared(sides) {
+		c, _ := heap.Pop(queue).(*object.Commit)
+
+		for _, p := range c.ParentHashes {
+			if err := push(p, sides[c.Hash]); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	return sides, nil
+}
+
+// unpushed returns the commits reachable from the local commit that are not
+// reachable from any remote-tracking branch. Every commit is unpushed when
+// there are no remote-tracking branches.
+func unpushed(b Brancher, local plumbing.Hash) ([]plumbing.Hash, error) {
+	tips, err := remoteTips(b)
+	if err != nil {
+		return nil, err
+	}
+
+	sides, err := walkSides(b, local, tips)
+	if err != nil {
+		return nil, err
+	}
+
+	var hs []plumbing.Hash
+
+	for h, side := range sides {
+		if side == sideLocal {
+			hs = append(hs, h)
+		}
+	}
+
+	return hs, nil
+}
+
+// remoteTips returns the commits of the remote-tracking branches. Symbolic
+// references such as the remote head are skipped as they point to a branch.
+func remoteTips(b Brancher) ([]plumbing.Hash, error) {
+	rs, err := b.References()
+	if err != nil {
+		return nil, fmt.Errorf("unable to get references: %w", err)
+	}
+
+	var tips []plumbing.Hash
+
+	err = rs.ForEach(func(ref *plumbing.Reference) error {
+		if ref.Name().IsRemote() && ref.Type() == plumbing.HashReference {
+			tips = append(tips, ref.Hash())
+		}
+
+		return nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("unable to get references: %w", err)
+	}
+
+	return tips, nil
+}
+
+// commitQueue orders commits by committer date with the newest first.
+type commitQueue []*object.Commit
+
+func (q commitQueue) Len() int {
+	return len(q)
+}
+
+func (q commitQueue) Less(i, j int) bool {
+	return q[i].Committer.When.After(q[j].Committer.When)
+}
+
+func (q commitQueue) Swap(i, j int) {
+	q[i], q[j] = q[j], q[i]
+}
+
+func (q *commitQueue) Push(x any) {
+	c, _ := x.(*object.Commit)
+	*q = append(*q, c)
+}
+
+func (q *commitQueue) Pop() any {
+	old := *q
+	n := len(old)
+	c := old[n-1]
+	*q = old[:n-1]
+
+	return c
+}
+
+func (q commitQueue) shared(sides map[plumbing.Hash]int) bool {
+	for _, c := range q {
+		if sides[c.Hash] != sideBoth {
+			return false
+		}
+	}
+
+	return true
+}
@@ -7,9 +7,9 @@ import (
 )
 
 type User struct {
-	Name    string `yaml:"name,omitempty"`
-	Email   string `yaml:"email,omitempty"`
-	Default bool   `yaml:"default,omitempty"`
+	Name    string `json:"name" yaml:"name,omitempty"`
+	Email   string `json:"email" yaml:"email,omitempty"`
+	Default bool   `json:"default,omitempty" yaml:"default,omitempty"`
 }
 
 func (r *Repository) Users() ([]User, error) {
@@ -1,15 +1,20 @@
 package repository
 
 import (
+	"encoding/json"
 	"fmt"
 	"os/exec"
+	"slices"
 	"strings"
+	"sync"
 
 	"github.com/go-git/go-git/v5"
 )
 
 type Worktree struct {
+	Root   string
 	Status git.Status
+	Differ func() string
 }
 
 func (r *Repository) Worktree() (Worktree, error) {
@@ -28,10 +33,22 @@ func (r *Repository) Worktree() (Worktree, error) {
 		return Worktree{}, fmt.Errorf("unable to get status of worktree: %w", err)
 	}
 	wt.Status = s
+	wt.Root = w.Filesystem.Root()
+	wt.Differ = sync.OnceValue(func() string { return stagedDiff(w) })
 
 	return wt, nil
 }
 
+// Diff returns the staged changes. The diff is only computed when first
+// requested as most commits do not need it.
+func (w *Worktree) Diff() string {
+	if w.Differ == nil {
+		return ""
+	}
+
+	return w.Differ()
+}
+
 func (w *Worktree) IsStaged() bool {
 	for _, s := range w.Status {
 		if s.Staging != git.Unmodified && s.Staging != git.Untracked {
@@ -42,6 +59,49 @@ func (w *Worktree) IsStaged() bool {
 	return false
 }
 
+// IsClean reports whether the tracked files have no changes, staged or not.
+// Untracked files are ignored.
+func (w *Worktree) IsClean() bool {
+	for _, s := range w.Status {
+		for _, c := range []git.StatusCode{s.Staging, s.Worktree} {
+			switch c {
+			case 0, git.Unmodified, git.Untracked:
+			default:
+				return false
+			}
+		}
+	}
+
+	return true
+}
+
+// MarshalJSON encodes the root and the staged files. The status and diff are
+// left out as the diff is only read when needed.
+func (w Worktree) MarshalJSON() ([]byte, error) {
+	return json.Marshal(struct {
+		Root   string   `json:"root"`
+		Staged []string `json:"staged"`
+	}{
+		Root:   w.Root,
+		Staged: w.Staged(),
+	})
+}
+
+// Staged returns the sorted paths of the staged files.
+func (w *Worktree) Staged() []string {
+	var paths []string
+
+	for p, s := range w.Status {
+		if s.Staging != git.Unmodified && s.Staging != git.Untracked {
+			paths = append(paths, p)
+		}
+	}
+
+	slices.Sort(paths)
+
+	return paths
+}
+
 // Alternative method to determine file status. Modified from original
 // version which was part of the following pull request.
 // https://github.com/zricethezav/gitleaks/pull/463
@@ -79,3 +139,17 @@ func status(wt *git.Worktree) (git.Status, error) {
 
 	return status, err
 }
+
+// stagedDiff returns the staged changes. An empty diff is returned when Git
+// is unavailable as the diff is only used for suggestions.
+func stagedDiff(wt *git.Worktree) string {
+	c := exec.Command("git", "diff", "--cached", "--no-color", "--no-ext-diff")
+	c.Dir = wt.Filesystem.Root()
+
+	out, err := c.Output()
+	if err != nil {
+		return ""
+	}
+
+	return string(out)
+}
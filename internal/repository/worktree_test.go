@@ -1,6 +1,7 @@
 package repository_test
 
 import (
+	"encoding/json"
 	"errors"
 	"testing"
 
@@ -223,3 +224,161 @@ func TestIsStaged(t *testing.T) {
 		})
 	}
 }
+
+func TestIsClean(t *testing.T) {
+	tests := []struct {
+		name   string
+		status git.Status
+		want   bool
+	}{
+		{
+			name: "unmodified",
+			status: git.Status{
+				"main.go": &git.FileStatus{Staging: git.Unmodified, Worktree: git.Unmodified},
+			},
+			want: true,
+		},
+		{
+			name: "untracked",
+			status: git.Status{
+				"main.go": &git.FileStatus{Staging: git.Untracked, Worktree: git.Untracked},
+			},
+			want: true,
+		},
+		{
+			name: "staged",
+			status: git.Status{
+				"main.go": &git.FileStatus{Staging: git.Modified, Worktree: git.Unmodified},
+			},
+			want: false,
+		},
+		{
+			name: "unstaged",
+			status: git.Status{
+				"main.go": &git.FileStatus{Staging: git.Unmodified, Worktree: git.Modified},
+			},
+			want: false,
+		},
+		{
+			name: "staging_only",
+			status: git.Status{
+				"main.go": &git.FileStatus{Staging: git.Deleted},
+			},
+			want: false,
+		},
+		{
+			name: "empty",
+			want: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wt := repository.Worktree{
+				Status: tt.status,
+			}
+
+			assert.Equal(t, tt.want, wt.IsClean())
+		})
+	}
+}
+
+func TestStaged(t *testing.T) {
+	tests := []struct {
+		name   string
+		status git.Status
+		want   []string
+	}{
+		{
+			name: "sorted",
+			status: git.Status{
+				"main.go":   &git.FileStatus{Staging: git.Modified},
+				"go.mod":    &git.FileStatus{Staging: git.Added},
+				"README.md": &git.FileStatus{Staging: git.Deleted},
+			},
+			want: []string{"README.md", "go.mod", "main.go"},
+		},
+		{
+			name: "mixed",
+			status: git.Status{
+				"main.go":  &git.FileStatus{Staging: git.Modified},
+				"draft.go": &git.FileStatus{Staging: git.Untracked},
+				"test.go":  &git.FileStatus{Staging: git.Unmodified, Worktree: git.Modified},
+			},
+			want: []string{"main.go"},
+		},
+		{
+			name: "nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wt := repository.Worktree{
+				Status: tt.status,
+			}
+
+			assert.Equal(t, tt.want, wt.Staged(), tt.name)
+		})
+	}
+}
+
+func TestWorktreeDiff(t *testing.T) {
+	tests := []struct {
+		name   string
+		differ func() string
+		want   string
+	}{
+		{
+			name:   "diff",
+			differ: func() string { return "+added\n" },
+			want:   "+added\n",
+		},
+		{
+			name: "nil",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			wt := repository.Worktree{
+				Differ: tt.differ,
+			}
+
+			assert.Equal(t, tt.want, wt.Diff(), tt.name)
+		})
+	}
+}
+
+func TestWorktreeMarshalJSON(t *testing.T) {
+	tests := []struct {
+		name string
+		wt   repository.Worktree
+		want string
+	}{
+		{
+			name: "staged",
+			wt: repository.Worktree{
+				Root: "/repo",
+				Status: git.Status{
+					"main.go":  &git.FileStatus{Staging: git.Modified},
+					"draft.go": &git.FileStatus{Staging: git.Untracked},
+				},
+				Differ: func() string { return "+added\n" },
+			},
+			want: `{"root":"/repo","staged":["main.go"]}`,
+		},
+		{
+			name: "empty",
+			want: `{"root":"","staged":null}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.wt)
+			assert.NoError(t, err)
+			assert.JSONEq(t, tt.want, string(data), tt.name)
+		})
+	}
+}
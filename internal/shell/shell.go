@@ -5,13 +5,23 @@ import (
 	"fmt"
 	"io"
 	"io/fs"
+	"os"
 	"os/exec"
 
 	"github.com/creack/pty"
 )
 
 func Run(w io.Writer, command string, args []string) error {
+	return RunEnv(w, command, args, nil)
+}
+
+// RunEnv runs the command with the variables added to the environment.
+func RunEnv(w io.Writer, command string, args []string, env []string) error {
 	cmd := exec.Command(command, args...)
+
+	if len(env) > 0 {
+		cmd.Env = append(os.Environ(), env...)
+	}
 	fh, err := pty.Start(cmd)
 	if err != nil {
 		var execError *exec.Error
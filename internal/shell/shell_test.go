@@ -137,3 +137,13 @@ func TestRun(t *testing.T) {
 		})
 	}
 }
+
+func TestRunEnv(t *testing.T) {
+	t.Parallel()
+
+	var buf bytes.Buffer
+
+	err := shell.RunEnv(&buf, "sh", []string{"-c", "echo $COMMITTED_TEST"}, []string{"COMMITTED_TEST=test"})
+	assert.NoError(t, err)
+	assert.Equal(t, "test", strings.TrimSpace(buf.String()))
+}
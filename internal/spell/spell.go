@@ -0,0 +1,400 @@
+package spell
+
+import (
+	"bufio"
+	_ "embed"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/x/ansi"
+)
+
+type Checker struct {
+	words map[string]struct{}
+}
+
+// Span is the location of a misspelled word measured in cells.
+type Span struct {
+	Word  string
+	Start int
+	End   int
+}
+
+//go:embed words.txt
+var defaultWords string
+
+const (
+	minLength      = 2
+	maxSuggestions = 5
+)
+
+// Characters that surround words in sentences.
+const punctuation = `()[]{}"'.,;:!?`
+
+// Characters that indicate a field is a path, identifier or URL.
+const ignoreChars = "/\\._:=<>@#$%^&*+{}[]|~`"
+
+// Suffixes removed when a word is not found. The replacement is appended to
+// the remaining stem.
+var suffixes = []struct {
+	suffix      string
+	replacement string
+}{
+	{"'s", ""},
+	{"s", ""},
+	{"es", ""},
+	{"ies", "y"},
+	{"ed", ""},
+	{"ed", "e"},
+	{"ied", "y"},
+	{"ing", ""},
+	{"ing", "e"},
+	{"ly", ""},
+	{"er", ""},
+	{"ers", ""},
+	{"est", ""},
+	{"ness", ""},
+	{"ment", ""},
+	{"ments", ""},
+}
+
+const (
+	letters = "abcdefghijklmnopqrstuvwxyz"
+	digits  = "0123456789"
+)
+
+func New(opts ...func(*Checker)) *Checker {
+	c := Checker{
+		words: make(map[string]struct{}),
+	}
+
+	c.add(strings.Fields(defaultWords)...)
+
+	for _, o := range opts {
+		if o != nil {
+			o(&c)
+		}
+	}
+
+	return &c
+}
+
+// WithWords adds words from a dictionary to the checker.
+func WithWords(ws ...string) func(*Checker) {
+	return func(c *Checker) {
+		c.add(ws...)
+	}
+}
+
+// Words reads a dictionary with one word per line. Empty lines and lines
+// starting with a hash are ignored.
+func Words(r io.Reader) ([]string, error) {
+	var ws []string
+
+	scanner := bufio.NewScanner(r)
+	for scanner.Scan() {
+		w := strings.TrimSpace(scanner.Text())
+		if w == "" || strings.HasPrefix(w, "#") {
+			continue
+		}
+
+		ws = append(ws, w)
+	}
+
+	if err := scanner.Err(); err != nil {
+		return nil, fmt.Errorf("unable to read dictionary: %w", err)
+	}
+
+	return ws, nil
+}
+
+// Check reports if the word is spelled correctly. Acronyms and words with
+// mixed case are assumed to be identifiers and are always correct.
+func (c *Checker) Check(word string) bool {
+	if c == nil || len([]rune(word)) < minLength || isIdentifier(word) {
+		return true
+	}
+
+	w := strings.ToLower(word)
+
+	if c.known(w) {
+		return true
+	}
+
+	for _, s := range suffixes {
+		stem, ok := strings.CutSuffix(w, s.suffix)
+		if ok && len(stem) >= minLength && c.known(stem+s.replacement) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// Misspelled returns the misspelled words of a line. Code spans, paths, URLs
+// and identifiers are ignored.
+func (c *Checker) Misspelled(str string) []Span {
+	if c == nil {
+		return nil
+	}
+
+	var spans []Span
+	var code bool
+
+	for _, f := range fields(str) {
+		f = trim(f)
+
+		ticks := strings.Count(f.text, "`")
+		skip := code || ticks > 0
+
+		if ticks%2 == 1 {
+			code = !code
+		}
+
+		if skip || strings.ContainsAny(f.text, ignoreChars+digits) {
+			continue
+		}
+
+		for _, w := range words(f.text) {
+			if c.Check(w.text) {
+				continue
+			}
+
+			start := ansi.StringWidth(str[:f.offset+w.offset])
+
+			spans = append(spans, Span{
+				Word:  w.text,
+				Start: start,
+				End:   start + ansi.StringWidth(w.text),
+			})
+		}
+	}
+
+	return spans
+}
+
+// Highlight renders the spans of a line which may already contain escape
+// sequences.
+func Highlight(str string, spans []Span, render func(string) string) string {
+	var sb strings.Builder
+
+	col := 0
+
+	for _, s := range spans {
+		sb.WriteString(ansi.Cut(str, col, s.Start))
+		sb.WriteString(render(ansi.Strip(ansi.Cut(str, s.Start, s.End))))
+
+		col = s.End
+	}
+
+	sb.WriteString(ansi.Cut(str, col, ansi.StringWidth(str)))
+
+	return sb.String()
+}
+
+// Mark highlights the misspelled words of each line of a rendered view. The
+// skip word is the word being edited which is not highlighted.
+func (c *Checker) Mark(view, skip string, render func(string) string) string {
+	if c == nil {
+		return view
+	}
+
+	lines := strings.Split(view, "\n")
+
+	for i, l := range lines {
+		spans := slices.DeleteFunc(c.Misspelled(ansi.Strip(l)), func(s Span) bool {
+			return s.Word == skip
+		})
+
+		lines[i] = Highlight(l, spans, render)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// WordAt returns the word at the column of the line and its start and end
+// column. The word before the column is used when the column is at the end of
+// a word.
+func WordAt(line string, col int) (string, int, int) {
+	rs := []rune(line)
+	col = min(max(col, 0), len(rs))
+
+	start := col
+	for start > 0 && isLetter(rs, start-1) {
+		start--
+	}
+
+	end := col
+	for end < len(rs) && isLetter(rs, end) {
+		end++
+	}
+
+	word := strings.TrimRight(string(rs[start:end]), "'")
+
+	return word, start, start + len([]rune(word))
+}
+
+// Suggest returns known words that are one or two edits from the word. The
+// case of the first letter is kept.
+func (c *Checker) Suggest(word string) []string {
+	if c == nil || word == "" {
+		return nil
+	}
+
+	w := strings.ToLower(word)
+
+	es := edits(w)
+	ss := c.knownEdits(es)
+
+	if len(ss) == 0 {
+		var es2 []string
+		for _, e := range es {
+			es2 = append(es2, edits(e)...)
+		}
+
+		ss = c.knownEdits(es2)
+	}
+
+	if len(ss) > maxSuggestions {
+		ss = ss[:maxSuggestions]
+	}
+
+	if unicode.IsUpper([]rune(word)[0]) {
+		for i, s := range ss {
+			ss[i] = strings.ToUpper(s[:1]) + s[1:]
+		}
+	}
+
+	return ss
+}
+
+func (c *Checker) add(ws ...string) {
+	for _, w := range ws {
+		c.words[strings.ToLower(w)] = struct{}{}
+	}
+}
+
+func (c *Checker) known(w string) bool {
+	_, ok := c.words[w]
+
+	return ok
+}
+
+func (c *Checker) knownEdits(es []string) []string {
+	var ss []string
+
+	for _, e := range es {
+		if c.known(e) && !slices.Contains(ss, e) {
+			ss = append(ss, e)
+		}
+	}
+
+	return ss
+}
+
+type token struct {
+	text   string
+	offset int
+}
+
+// fields splits the string on spaces keeping the byte offset of each field.
+func fields(str string) []token {
+	var ts []token
+
+	for i := 0; i < len(str); {
+		if str[i] == ' ' || str[i] == '\t' {
+			i++
+
+			continue
+		}
+
+		j := strings.IndexAny(str[i:], " \t")
+		if j == -1 {
+			j = len(str) - i
+		}
+
+		ts = append(ts, token{text: str[i : i+j], offset: i})
+		i += j
+	}
+
+	return ts
+}
+
+// trim removes punctuation surrounding a field.
+func trim(t token) token {
+	text := strings.TrimLeft(t.text, punctuation)
+	t.offset += len(t.text) - len(text)
+	t.text = strings.TrimRight(text, punctuation)
+
+	return t
+}
+
+// words splits a field into words on hyphens and removes punctuation.
+func words(field string) []token {
+	var ts []token
+
+	start := -1
+
+	for i, r := range field + " " {
+		isWord := unicode.IsLetter(r) || (r == '\'' && start != -1)
+
+		switch {
+		case isWord && start == -1:
+			start = i
+		case !isWord && start != -1:
+			w := strings.TrimRight(field[start:i], "'")
+			ts = append(ts, token{text: w, offset: start})
+			start = -1
+		}
+	}
+
+	return ts
+}
+
+func isLetter(rs []rune, i int) bool {
+	return unicode.IsLetter(rs[i]) || (rs[i] == '\'' && i > 0 && unicode.IsLetter(rs[i-1]))
+}
+
+func isIdentifier(word string) bool {
+	rs := []rune(word)
+
+	for _, r := range rs[1:] {
+		if unicode.IsUpper(r) {
+			return true
+		}
+	}
+
+	return false
+}
+
+// edits returns words one edit away ordered by the most likely edit.
+func edits(w string) []string {
+	rs := []rune(w)
+
+	var transposes, replaces, inserts, deletes []string
+
+	for i := 0; i <= len(rs); i++ {
+		left, right := string(rs[:i]), string(rs[i:])
+
+		if i < len(rs)-1 {
+			transposes = append(transposes, left+string(rs[i+1])+string(rs[i])+string(rs[i+2:]))
+		}
+
+		for _, l := range letters {
+			if i < len(rs) {
+				replaces = append(replaces, left+string(l)+string(rs[i+1:]))
+			}
+
+			inserts = append(inserts, left+string(l)+right)
+		}
+
+		if i < len(rs) {
+			deletes = append(deletes, left+string(rs[i+1:]))
+		}
+	}
+
+	return slices.Concat(transposes, replaces, inserts, deletes)
+}
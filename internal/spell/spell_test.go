@@ -0,0 +1,356 @@
+package spell_test
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/mikelorant/committed/internal/spell"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheck(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		word  string
+		words []string
+		want  bool
+	}{
+		{name: "known", word: "commit", want: true},
+		{name: "capitalised", word: "Commit", want: true},
+		{name: "plural", word: "themes", want: true},
+		{name: "past", word: "configured", want: true},
+		{name: "progressive", word: "removing", want: true},
+		{name: "contraction", word: "doesn't", want: true},
+		{name: "possessive", word: "user's", want: true},
+		{name: "misspelled", word: "recieve", want: false},
+		{name: "unknown", word: "frobnicate", want: false},
+		{name: "dictionary", word: "frobnicate", words: []string{"Frobnicate"}, want: true},
+		{name: "acronym", word: "YAML", want: true},
+		{name: "identifier", word: "keyMap", want: true},
+		{name: "single", word: "x", want: true},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			c := spell.New(spell.WithWords(tt.words...))
+
+			assert.Equal(t, tt.want, c.Check(tt.word))
+		})
+	}
+}
+
+func TestMisspelled(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		str  string
+		want []spell.Span
+	}{
+		{
+			name: "empty",
+		},
+		{
+			name: "correct",
+			str:  "Fix the cursor position",
+		},
+		{
+			name: "misspelled",
+			str:  "Fix teh cursor posision",
+			want: []spell.Span{
+				{Word: "teh", Start: 4, End: 7},
+				{Word: "posision", Start: 15, End: 23},
+			},
+		},
+		{
+			name: "punctuation",
+			str:  "(teh), \"recieve\".",
+			want: []spell.Span{
+				{Word: "teh", Start: 1, End: 4},
+				{Word: "recieve", Start: 8, End: 15},
+			},
+		},
+		{
+			name: "hyphen",
+			str:  "sign-off and sign-ofg",
+			want: []spell.Span{
+				{Word: "ofg", Start: 18, End: 21},
+			},
+		},
+		{
+			name: "code",
+			str:  "Use `teh` and ``recieve posision`` spans",
+		},
+		{
+			name: "path",
+			str:  "Update internal/teh/recieve.go",
+		},
+		{
+			name: "url",
+			str:  "See https://example.com/teh",
+		},
+		{
+			name: "identifier",
+			str:  "Rename snake_case and camelCase and v2beta",
+		},
+		{
+			name: "wide",
+			str:  "🎨 teh",
+			want: []spell.Span{
+				{Word: "teh", Start: 3, End: 6},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := spell.New().Misspelled(tt.str)
+
+			assert.ElementsMatch(t, tt.want, got)
+		})
+	}
+}
+
+func TestSuggest(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		word string
+		want []string
+	}{
+		{name: "empty"},
+		{name: "transpose", word: "teh", want: []string{"the", "tea", "tee", "ten", "teq"}},
+		{name: "insert", word: "recieve", want: []string{"receive"}},
+		{name: "capitalised", word: "Recieve", want: []string{"Receive"}},
+		{name: "distance", word: "recievd", want: []string{"receive", "received", "relied"}},
+		{name: "none", word: "zzzzzzzzzz"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := spell.New().Suggest(tt.word)
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWords(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		reader func() *strings.Reader
+		want   []string
+	}{
+		{
+			name:   "empty",
+			reader: func() *strings.Reader { return strings.NewReader("") },
+		},
+		{
+			name:   "words",
+			reader: func() *strings.Reader { return strings.NewReader("one\n\n# comment\n two \n") },
+			want:   []string{"one", "two"},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := spell.Words(tt.reader())
+			assert.NoError(t, err)
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWordsError(t *testing.T) {
+	t.Parallel()
+
+	_, err := spell.Words(errReader{})
+	assert.ErrorIs(t, err, errMockRead)
+}
+
+func TestHighlight(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name  string
+		str   string
+		spans []spell.Span
+		want  string
+	}{
+		{
+			name: "none",
+			str:  "the",
+			want: "the",
+		},
+		{
+			name:  "spans",
+			str:   "a teh b recieve",
+			spans: []spell.Span{{Start: 2, End: 5}, {Start: 8, End: 15}},
+			want:  "a [teh] b [recieve]",
+		},
+		{
+			name:  "escape",
+			str:   "\x1b[1mteh\x1b[0m the",
+			spans: []spell.Span{{Start: 0, End: 3}},
+			want:  "[teh]\x1b[1m\x1b[0m the",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := spell.Highlight(tt.str, tt.spans, func(s string) string {
+				return "[" + s + "]"
+			})
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+var errMockRead = errors.New("error")
+
+type errReader struct{}
+
+func (errReader) Read([]byte) (int, error) {
+	return 0, errMockRead
+}
+
+func TestMark(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name    string
+		checker *spell.Checker
+		view    string
+		skip    string
+		want    string
+	}{
+		{
+			name:    "lines",
+			checker: spell.New(),
+			view:    "fix teh\nrecieve the",
+			want:    "fix [teh]\n[recieve] the",
+		},
+		{
+			name:    "skip",
+			checker: spell.New(),
+			view:    "fix teh\nrecieve the",
+			skip:    "recieve",
+			want:    "fix [teh]\nrecieve the",
+		},
+		{
+			name: "nil",
+			view: "fix teh",
+			want: "fix teh",
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			got := tt.checker.Mark(tt.view, tt.skip, func(s string) string {
+				return "[" + s + "]"
+			})
+
+			assert.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestWordAt(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		word  string
+		start int
+		end   int
+	}
+
+	tests := []struct {
+		name string
+		line string
+		col  int
+		want want
+	}{
+		{
+			name: "start",
+			line: "fix teh bug",
+			col:  4,
+			want: want{word: "teh", start: 4, end: 7},
+		},
+		{
+			name: "middle",
+			line: "fix teh bug",
+			col:  5,
+			want: want{word: "teh", start: 4, end: 7},
+		},
+		{
+			name: "end",
+			line: "fix teh",
+			col:  7,
+			want: want{word: "teh", start: 4, end: 7},
+		},
+		{
+			name: "apostrophe",
+			line: "it doesn't",
+			col:  4,
+			want: want{word: "doesn't", start: 3, end: 10},
+		},
+		{
+			name: "space",
+			line: "fix  teh",
+			col:  4,
+			want: want{start: 4, end: 4},
+		},
+		{
+			name: "out_of_range",
+			line: "teh",
+			col:  9,
+			want: want{word: "teh", start: 0, end: 3},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			word, start, end := spell.WordAt(tt.line, tt.col)
+
+			assert.Equal(t, tt.want.word, word)
+			assert.Equal(t, tt.want.start, start)
+			assert.Equal(t, tt.want.end, end)
+		})
+	}
+}
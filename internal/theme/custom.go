@@ -0,0 +1,224 @@
+package theme
+
+import (
+	"github.com/mikelorant/committed/internal/config"
+
+	"github.com/charmbracelet/lipgloss"
+	tint "github.com/lrstanley/bubbletint"
+)
+
+type Components map[string]string
+
+type customTint struct {
+	id          string
+	displayName string
+	base        tint.Tint
+	palette     config.Palette
+}
+
+// Custom registers the themes defined in the config alongside the tints of
+// t. A theme with palette colours creates a new tint (or replaces a tint with
+// the same ID) using the base tint for any colours that are not defined. A
+// theme without palette colours only overrides component colours.
+func Custom(t Tint, ths []config.CustomTheme) Tint {
+	for _, th := range ths {
+		if th.ID == "" {
+			continue
+		}
+
+		if len(th.Components) > 0 {
+			if t.Overrides == nil {
+				t.Overrides = make(map[string]Components)
+			}
+
+			t.Overrides[th.ID] = Components(th.Components)
+		}
+
+		existing, ok := findTint(t.Defaults, th.ID)
+		if ok && th.Palette.IsZero() {
+			continue
+		}
+
+		base := t.Default
+		switch {
+		case th.Base != "":
+			if bt, ok := findTint(t.Defaults, th.Base); ok {
+				base = bt
+			}
+		case ok:
+			base = existing
+		}
+
+		ct := newCustomTint(th, base)
+
+		t.Defaults = replaceTint(t.Defaults, ct)
+		if t.Default != nil && t.Default.ID() == ct.ID() {
+			t.Default = ct
+		}
+	}
+
+	return t
+}
+
+func newCustomTint(th config.CustomTheme, base tint.Tint) customTint {
+	name := th.Name
+	if name == "" {
+		name = th.ID
+	}
+
+	return customTint{
+		id:          th.ID,
+		displayName: name,
+		base:        base,
+		palette:     th.Palette,
+	}
+}
+
+func (t customTint) DisplayName() string {
+	return t.displayName
+}
+
+func (t customTint) ID() string {
+	return t.id
+}
+
+func (t customTint) About() string {
+	return "Tint: " + t.displayName
+}
+
+//nolint:ireturn
+func (t customTint) Fg() lipgloss.TerminalColor {
+	return t.colour(t.palette.Fg, tint.Tint.Fg)
+}
+
+//nolint:ireturn
+func (t customTint) Bg() lipgloss.TerminalColor {
+	return t.colour(t.palette.Bg, tint.Tint.Bg)
+}
+
+//nolint:ireturn
+func (t customTint) SelectionBg() lipgloss.TerminalColor {
+	return t.colour(t.palette.SelectionBg, tint.Tint.SelectionBg)
+}
+
+//nolint:ireturn
+func (t customTint) Cursor() lipgloss.TerminalColor {
+	return t.colour(t.palette.Cursor, tint.Tint.Cursor)
+}
+
+//nolint:ireturn
+func (t customTint) BrightBlack() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightBlack, tint.Tint.BrightBlack)
+}
+
+//nolint:ireturn
+func (t customTint) BrightBlue() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightBlue, tint.Tint.BrightBlue)
+}
+
+//nolint:ireturn
+func (t customTint) BrightCyan() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightCyan, tint.Tint.BrightCyan)
+}
+
+//nolint:ireturn
+func (t customTint) BrightGreen() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightGreen, tint.Tint.BrightGreen)
+}
+
+//nolint:ireturn
+func (t customTint) BrightPurple() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightPurple, tint.Tint.BrightPurple)
+}
+
+//nolint:ireturn
+func (t customTint) BrightRed() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightRed, tint.Tint.BrightRed)
+}
+
+//nolint:ireturn
+func (t customTint) BrightWhite() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightWhite, tint.Tint.BrightWhite)
+}
+
+//nolint:ireturn
+func (t customTint) BrightYellow() lipgloss.TerminalColor {
+	return t.colour(t.palette.BrightYellow, tint.Tint.BrightYellow)
+}
+
+//nolint:ireturn
+func (t customTint) Black() lipgloss.TerminalColor {
+	return t.colour(t.palette.Black, tint.Tint.Black)
+}
+
+//nolint:ireturn
+func (t customTint) Blue() lipgloss.TerminalColor {
+	return t.colour(t.palette.Blue, tint.Tint.Blue)
+}
+
+//nolint:ireturn
+func (t customTint) Cyan() lipgloss.TerminalColor {
+	return t.colour(t.palette.Cyan, tint.Tint.Cyan)
+}
+
+//nolint:ireturn
+func (t customTint) Green() lipgloss.TerminalColor {
+	return t.colour(t.palette.Green, tint.Tint.Green)
+}
+
+//nolint:ireturn
+func (t customTint) Purple() lipgloss.TerminalColor {
+	return t.colour(t.palette.Purple, tint.Tint.Purple)
+}
+
+//nolint:ireturn
+func (t customTint) Red() lipgloss.TerminalColor {
+	return t.colour(t.palette.Red, tint.Tint.Red)
+}
+
+//nolint:ireturn
+func (t customTint) White() lipgloss.TerminalColor {
+	return t.colour(t.palette.White, tint.Tint.White)
+}
+
+//nolint:ireturn
+func (t customTint) Yellow() lipgloss.TerminalColor {
+	return t.colour(t.palette.Yellow, tint.Tint.Yellow)
+}
+
+//nolint:ireturn
+func (t customTint) colour(clr string, fallback func(tint.Tint) lipgloss.TerminalColor) lipgloss.TerminalColor {
+	switch {
+	case clr != "":
+		return lipgloss.Color(clr)
+	case t.base != nil:
+		return fallback(t.base)
+	}
+
+	return lipgloss.NoColor{}
+}
+
+//nolint:ireturn
+func findTint(ts []tint.Tint, id string) (tint.Tint, bool) {
+	for _, t := range ts {
+		if t.ID() == id {
+			return t, true
+		}
+	}
+
+	return nil, false
+}
+
+func replaceTint(ts []tint.Tint, t tint.Tint) []tint.Tint {
+	res := make([]tint.Tint, 0, len(ts)+1)
+
+	for _, v := range ts {
+		if v.ID() == t.ID() {
+			continue
+		}
+
+		res = append(res, v)
+	}
+
+	return append(res, t)
+}
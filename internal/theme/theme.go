@@ -10,21 +10,24 @@ import (
 )
 
 type Theme struct {
-	ID       string
-	Registry *tint.Registry
+	ID        string
+	Registry  *tint.Registry
+	Overrides map[string]Components
 }
 
 type Tint struct {
-	Default  tint.Tint
-	Defaults []tint.Tint
+	Default   tint.Tint
+	Defaults  []tint.Tint
+	Overrides map[string]Components
 }
 
 func New(t Tint) Theme {
 	reg := tint.NewRegistry(t.Default, t.Defaults...)
 
 	return Theme{
-		ID:       reg.ID(),
-		Registry: reg,
+		ID:        reg.ID(),
+		Registry:  reg,
+		Overrides: t.Overrides,
 	}
 }
 
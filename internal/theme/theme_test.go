@@ -1,6 +1,7 @@
 package theme_test
 
 import (
+	"fmt"
 	"testing"
 
 	"github.com/mikelorant/committed/internal/config"
@@ -223,6 +224,131 @@ func TestSet(t *testing.T) {
 	}
 }
 
+func TestCustom(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		ids       []string
+		id        string
+		fg        string
+		red       string
+		overrides map[string]theme.Components
+	}
+
+	tests := []struct {
+		name   string
+		themes []config.CustomTheme
+		want   want
+	}{
+		{
+			name: "none",
+			want: want{
+				ids: []string{"builtin_dark", "builtin_light"},
+				id:  "builtin_dark",
+				fg:  "#bbbbbb",
+				red: "#bb0000",
+			},
+		},
+		{
+			name: "empty_id",
+			themes: []config.CustomTheme{
+				{Palette: config.Palette{Fg: "#ffffff"}},
+			},
+			want: want{
+				ids: []string{"builtin_dark", "builtin_light"},
+				id:  "builtin_dark",
+				fg:  "#bbbbbb",
+				red: "#bb0000",
+			},
+		},
+		{
+			name: "new",
+			themes: []config.CustomTheme{
+				{ID: "custom", Palette: config.Palette{Fg: "#ffffff"}},
+			},
+			want: want{
+				ids: []string{"builtin_dark", "builtin_light", "custom"},
+				id:  "custom",
+				fg:  "#ffffff",
+				red: "#bb0000",
+			},
+		},
+		{
+			name: "new_base",
+			themes: []config.CustomTheme{
+				{ID: "custom", Base: "builtin_light", Palette: config.Palette{Red: "#ff0000"}},
+			},
+			want: want{
+				ids: []string{"builtin_dark", "builtin_light", "custom"},
+				id:  "custom",
+				fg:  "#000000",
+				red: "#ff0000",
+			},
+		},
+		{
+			name: "new_invalid_base",
+			themes: []config.CustomTheme{
+				{ID: "custom", Base: "invalid", Palette: config.Palette{Red: "#ff0000"}},
+			},
+			want: want{
+				ids: []string{"builtin_dark", "builtin_light", "custom"},
+				id:  "custom",
+				fg:  "#bbbbbb",
+				red: "#ff0000",
+			},
+		},
+		{
+			name: "replace",
+			themes: []config.CustomTheme{
+				{ID: "builtin_dark", Palette: config.Palette{Red: "#ff0000"}},
+			},
+			want: want{
+				ids: []string{"builtin_dark", "builtin_light"},
+				id:  "builtin_dark",
+				fg:  "#bbbbbb",
+				red: "#ff0000",
+			},
+		},
+		{
+			name: "components",
+			themes: []config.CustomTheme{
+				{ID: "builtin_dark", Components: map[string]string{"header.CounterWarning": "#ff0000"}},
+			},
+			want: want{
+				ids: []string{"builtin_dark", "builtin_light"},
+				id:  "builtin_dark",
+				fg:  "#bbbbbb",
+				red: "#bb0000",
+				overrides: map[string]theme.Components{
+					"builtin_dark": {"header.CounterWarning": "#ff0000"},
+				},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			tints := theme.Tint{
+				Default:  tint.TintBuiltinDark,
+				Defaults: []tint.Tint{tint.TintBuiltinDark, tint.TintBuiltinLight},
+			}
+
+			th := theme.New(theme.Custom(tints, tt.themes))
+			th.Set("custom")
+
+			assert.Equal(t, tt.want.ids, th.ListID())
+			assert.Equal(t, tt.want.id, th.ID)
+			assert.Equal(t, tt.want.fg, fmt.Sprint(th.Registry.Fg()))
+			assert.Equal(t, tt.want.red, fmt.Sprint(th.Registry.Red()))
+			assert.Equal(t, tt.want.overrides, th.Overrides)
+		})
+	}
+}
+
 func testIDs(n int) []string {
 	tints := themetest.NewStubTints(n)
 
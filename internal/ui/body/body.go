@@ -4,36 +4,54 @@ import (
 	"strings"
 
 	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/completion"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/spell"
 	"github.com/mikelorant/committed/internal/ui/colour"
+	"github.com/mikelorant/committed/internal/ui/suggestion"
+	"github.com/mikelorant/committed/internal/ui/vim"
 
 	"github.com/charmbracelet/bubbles/textarea"
 	tea "github.com/charmbracelet/bubbletea"
-	"github.com/charmbracelet/x/ansi"
 )
 
 type Model struct {
 	Height int
 	Width  int
 
-	focus    bool
-	state    *commit.State
-	styles   Styles
-	textArea textarea.Model
+	focus      bool
+	state      *commit.State
+	styles     Styles
+	textArea   textarea.Model
+	editor     *vim.Editor
+	completion suggestion.Model
+	items      []completion.Item
 }
 
 const (
 	tabSize = 4
 
 	defaultWidth = 72
+
+	completionTitle  = "Complete"
+	completionColumn = 1
 )
 
 func New(state *commit.State, h int) Model {
 	m := Model{
-		Height:   h,
-		Width:    defaultWidth,
-		state:    state,
-		styles:   defaultStyles(state.Theme),
-		textArea: newTextArea(state.Placeholders.Body, defaultWidth, state),
+		Height:     h,
+		Width:      defaultWidth,
+		state:      state,
+		styles:     defaultStyles(state.Theme),
+		textArea:   newTextArea(state.Placeholders.Body, defaultWidth, state),
+		completion: suggestion.New(state),
+	}
+
+	m.completion.Title = completionTitle
+
+	if state.Config.View.EditMode == config.EditModeVim {
+		e := vim.New()
+		m.editor = &e
 	}
 
 	return m
@@ -48,7 +66,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	var cmd tea.Cmd
 	var cmds []tea.Cmd
 
-	if m.focus {
+	if msg, ok := msg.(tea.KeyMsg); ok && m.focus && m.completion.Active() {
+		if m.updateCompletion(msg) {
+			return m, nil
+		}
+	}
+
+	if m.focus && !m.editing() {
 		//nolint:gocritic
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
@@ -65,6 +89,7 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg.(type) {
 	case colour.Msg:
 		m.styles = defaultStyles(m.state.Theme)
+		m.completion, _ = suggestion.ToModel(m.completion.Update(msg))
 		styleTextArea(&m.textArea, m.state)
 		switch m.textArea.Focused() {
 		case true:
@@ -84,20 +109,40 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, cmd
 	case !m.focus && m.textArea.Focused():
 		m.textArea.Blur()
+		m.completion.Close()
+	}
+
+	if m.focus && m.editor != nil {
+		if msg, ok := msg.(tea.KeyMsg); ok && m.updateEditor(msg) {
+			return m, nil
+		}
 	}
 
 	m.textArea, cmd = m.textArea.Update(msg)
 	cmds = append(cmds, cmd)
 
+	if _, ok := msg.(tea.KeyMsg); ok && m.completion.Active() {
+		m.Complete()
+	}
+
 	return m, tea.Batch(cmds...)
 }
 
 func (m Model) View() string {
+	view := m.textArea.View()
+	if m.textArea.Value() != "" {
+		view = m.state.Spelling.Mark(view, m.editingWord(), m.misspelled)
+	}
+
+	if m.completion.Active() {
+		view = m.completion.Overlay(view, completionColumn, 0)
+	}
+
 	if m.focus || !m.state.Config.View.HighlightActive {
-		return m.styles.focusBoundary.Height(m.Height).Render(m.textArea.View())
+		return m.styles.focusBoundary.Height(m.Height).Render(view)
 	}
 
-	return m.styles.boundary.Height(m.Height).Render(m.textArea.View())
+	return m.styles.boundary.Height(m.Height).Render(view)
 }
 
 func (m *Model) Focus() {
@@ -113,13 +158,7 @@ func (m Model) Focused() bool {
 }
 
 func (m Model) Value() string {
-	// Further details for the text reflow issue:
-	// https://github.com/charmbracelet/bubbles/issues/333
-	l := m.Width - 1
-
-	str := ansi.Wordwrap(m.textArea.Value(), l, "")
-
-	return strings.TrimSpace(str)
+	return commit.FormatBody(m.textArea.Value(), m.wrapWidth(), m.state.Config.Commit.Reflow)
 }
 
 func (m Model) RawValue() string {
@@ -130,6 +169,17 @@ func (m *Model) SetValue(str string) {
 	m.textArea.SetValue(str)
 }
 
+// Reflow rewraps the paragraphs and lists of the body.
+func (m *Model) Reflow() {
+	str := commit.Reflow(m.textArea.Value(), m.wrapWidth())
+	if str == m.textArea.Value() {
+		return
+	}
+
+	m.textArea.SetValue(str)
+	m.CursorStart()
+}
+
 func (m *Model) Reset() {
 	m.textArea.Reset()
 }
@@ -141,10 +191,162 @@ func (m *Model) CursorStart() {
 	}
 }
 
+// Word returns the word at the cursor.
+func (m Model) Word() string {
+	w, _, _ := spell.WordAt(m.line(), m.column())
+
+	return w
+}
+
+// ReplaceWord replaces the word at the cursor.
+func (m *Model) ReplaceWord(str string) {
+	_, start, end := spell.WordAt(m.line(), m.column())
+
+	m.replace(start, end, str)
+}
+
+// Complete shows the paths and identifiers of the staged changes matching the
+// word at the cursor.
+func (m *Model) Complete() {
+	word, _, _ := completion.WordAt(m.line(), m.column())
+	if m.editing() || word == "" {
+		m.completion.Close()
+
+		return
+	}
+
+	// The staged diff is only read once completion is first used.
+	if m.items == nil {
+		m.items = completion.New(m.state.Repository.Worktree)
+	}
+
+	ms := completion.Match(word, m.items)
+	if len(ms) == 0 {
+		m.completion.Close()
+
+		return
+	}
+
+	m.completion.Open(word, ms)
+}
+
+// Completing reports if completions are shown.
+func (m Model) Completing() bool {
+	return m.completion.Active()
+}
+
+// Mode returns the vim mode when modal editing is enabled.
+func (m Model) Mode() string {
+	if m.editor == nil {
+		return ""
+	}
+
+	return m.editor.Mode.String()
+}
+
 func ToModel(m tea.Model, c tea.Cmd) (Model, tea.Cmd) {
 	return m.(Model), c
 }
 
+// wrapWidth is narrower than the text area as lines that fill the width are
+// wrapped. Further details for the text reflow issue:
+// https://github.com/charmbracelet/bubbles/issues/333
+func (m Model) wrapWidth() int {
+	return m.Width - 1
+}
+
+// editing reports if keys are handled by the vim editor rather than the text
+// area.
+func (m Model) editing() bool {
+	return m.editor != nil && m.editor.Mode != vim.ModeInsert
+}
+
+func (m *Model) updateEditor(msg tea.KeyMsg) bool {
+	t, ok := m.editor.Update(msg.String(), vim.Text{
+		Value: m.textArea.Value(),
+		Row:   m.textArea.Line(),
+		Col:   m.column(),
+	})
+	if !ok {
+		return false
+	}
+
+	if t.Value != m.textArea.Value() {
+		m.textArea.SetValue(t.Value)
+	}
+
+	m.setCursor(t.Row, t.Col)
+
+	return true
+}
+
+// updateCompletion handles keys while completions are shown. Enter and tab
+// replace the word with the selected completion.
+func (m *Model) updateCompletion(msg tea.KeyMsg) bool {
+	switch msg.String() {
+	case "enter", "tab":
+		_, start, end := completion.WordAt(m.line(), m.column())
+		m.replace(start, end, m.completion.Selected())
+		m.completion.Close()
+	case "esc":
+		m.completion.Close()
+	case "up", "down", "shift+tab":
+		m.completion, _ = suggestion.ToModel(m.completion.Update(msg))
+	default:
+		return false
+	}
+
+	return true
+}
+
+// replace replaces the columns of the current line.
+func (m *Model) replace(start, end int, str string) {
+	row := m.textArea.Line()
+	lines := strings.Split(m.textArea.Value(), "\n")
+
+	rs := []rune(lines[row])
+	lines[row] = string(rs[:start]) + str + string(rs[end:])
+
+	m.textArea.SetValue(strings.Join(lines, "\n"))
+	m.setCursor(row, start+len([]rune(str)))
+}
+
+func (m *Model) setCursor(row, col int) {
+	for m.textArea.Line() > row {
+		m.textArea.CursorUp()
+	}
+
+	for m.textArea.Line() < row {
+		m.textArea.CursorDown()
+	}
+
+	m.textArea.SetCursor(col)
+}
+
+func (m Model) line() string {
+	return strings.Split(m.textArea.Value(), "\n")[m.textArea.Line()]
+}
+
+func (m Model) column() int {
+	li := m.textArea.LineInfo()
+
+	return li.StartColumn + li.ColumnOffset
+}
+
+func (m Model) misspelled(str string) string {
+	return m.styles.misspelled.Render(str)
+}
+
+// editingWord is the word at the cursor which is not marked as misspelled
+// while it is being typed.
+func (m Model) editingWord() string {
+	if !m.focus {
+		return ""
+	}
+
+	return m.Word()
+}
+
 func newTextArea(ph string, w int, state *commit.State) textarea.Model {
 	ta := textarea.New()
 	ta.Placeholder = ph
@@ -5,11 +5,14 @@ import (
 
 	"github.com/mikelorant/committed/internal/commit"
 	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/repository"
+	"github.com/mikelorant/committed/internal/spell"
 	"github.com/mikelorant/committed/internal/theme"
 	"github.com/mikelorant/committed/internal/ui/body"
 	"github.com/mikelorant/committed/internal/ui/uitest"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/go-git/go-git/v5"
 	"github.com/hexops/autogold/v2"
 	"github.com/stretchr/testify/assert"
 )
@@ -20,6 +23,7 @@ func TestModel(t *testing.T) {
 	type args struct {
 		body   string
 		height int
+		state  func(s *commit.State)
 		model  func(m body.Model) body.Model
 	}
 
@@ -190,6 +194,249 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reflow_command",
+			args: args{
+				model: func(m body.Model) body.Model {
+					m.Height = 5
+					m.Width = 10
+
+					m.SetValue("1 2 3 4 5 6\n- 1 2 3 4 5")
+					m.Reflow()
+					m, _ = body.ToModel(m.Update(m))
+					return m
+				},
+			},
+			want: want{
+				model: func(m body.Model) {
+					assert.Equal(t, "1 2 3 4 5\n6\n- 1 2 3 4\n  5", m.RawValue())
+				},
+			},
+		},
+		{
+			name: "reflow_commit",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.Commit.Reflow = true
+				},
+				model: func(m body.Model) body.Model {
+					m.Height = 5
+					m.Width = 10
+
+					m.SetValue("- 1 2 3 4 5")
+					m, _ = body.ToModel(m.Update(m))
+					return m
+				},
+			},
+			want: want{
+				model: func(m body.Model) {
+					assert.Equal(t, "- 1 2 3 4\n  5", m.Value())
+					assert.Equal(t, "- 1 2 3 4 5", m.RawValue())
+				},
+			},
+		},
+		{
+			name: "spelling_word",
+			args: args{
+				height: 3,
+				state: func(s *commit.State) {
+					s.Spelling = spell.New()
+				},
+				model: func(m body.Model) body.Model {
+					m.Focus()
+					m, _ = body.ToModel(m.Update(nil))
+					m, _ = body.ToModel(uitest.SendString(m, "fix\nteh bug"), nil)
+					return m
+				},
+			},
+			want: want{
+				model: func(m body.Model) {
+					assert.Equal(t, "bug", m.Word())
+				},
+			},
+		},
+		{
+			name: "spelling_replace",
+			args: args{
+				height: 3,
+				state: func(s *commit.State) {
+					s.Spelling = spell.New()
+				},
+				model: func(m body.Model) body.Model {
+					m.Focus()
+					m, _ = body.ToModel(m.Update(nil))
+					m, _ = body.ToModel(uitest.SendString(m, "fix\nteh bug"), nil)
+					m, _ = body.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyHome}))
+					m.ReplaceWord("the")
+					return m
+				},
+			},
+			want: want{
+				model: func(m body.Model) {
+					assert.Equal(t, "fix\nthe bug", m.RawValue())
+					assert.Equal(t, "the", m.Word())
+				},
+			},
+		},
+		{
+			name: "complete",
+			args: args{
+				height: 8,
+				state: func(s *commit.State) {
+					s.Repository.Worktree = testWorktree()
+				},
+				model: func(m body.Model) body.Model {
+					m.Focus()
+					m, _ = body.ToModel(m.Update(nil))
+					m, _ = body.ToModel(uitest.SendString(m, "call Re"), nil)
+					m.Complete()
+					return m
+				},
+			},
+			want: want{
+				model: func(m body.Model) {
+					assert.Equal(t, true, m.Completing())
+					assert.Equal(t, "call Re", m.RawValue())
+				},
+			},
+		},
+		{
+			name: "complete_select",
+			args: args{
+				height: 8,
+				state: func(s *commit.State) {
+					s.Repository.Worktree = testWorktree()
+				},
+				model: func(m body.Model) body.Model {
+					m.Focus()
+					m, _ = body.ToModel(m.Update(nil))
+					m, _ = body.ToModel(uitest.SendString(m, "call Re"), nil)
+					m.Complete()
+					m, _ = body.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					m, _ = body.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m body.Model) {
+					assert.Equal(t, false, m.Completing())
+					assert.Equal(t, "call replace", m.RawValue())
+				},
+			},
+		},
+		{
+			name: "complete_tab",
+			args: args{
+				height: 8,
+				state: func(s *commit.State) {
+					s.Repository.Worktree = testWorktree()
+				},
+				model: func(m body.Model) body.Model {
+					m.Focus()
+					m, _ = body.ToModel(m.Update(nil))
+					m, _ = body.ToModel(uitest.SendString(m, "edit bo"), nil)
+					m.Complete()
+					m, _ = body.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyTab}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m body.Model) {
+					assert.Equal(t, false, m.Completing())
+					assert.Equal(t, "edit internal/ui/body/body.go", m.RawValue())
+				},
+			},
+		},
+		{
+			name: "complete_filter",
+			args: args{
+				height: 8,
+				state: func(s *commit.State) {
+					s.Repository.Worktree = testWorktree()
+				},
+				model: func(m body.Model) body.Model {
+					m.Focus()
+					m, _ = body.ToModel(m.Update(nil))
+					m, _ = body.ToModel(uitest.SendString(m, "call Re"), nil)
+					m.Complete()
+					m, _ = body.ToModel(uitest.SendString(m, "pla"), nil)
+					return m
+				},
+			},
+			want: want{
+				model: func(m body.Model) {
+					assert.Equal(t, true, m.Completing())
+					assert.Equal(t, "call Repla", m.RawValue())
+				},
+			},
+		},
+		{
+			name: "complete_filter_none",
+			args: args{
+				height: 8,
+				state: func(s *commit.State) {
+					s.Repository.Worktree = testWorktree()
+				},
+				model: func(m body.Model) body.Model {
+					m.Focus()
+					m, _ = body.ToModel(m.Update(nil))
+					m, _ = body.ToModel(uitest.SendString(m, "call Re"), nil)
+					m.Complete()
+					m, _ = body.ToModel(uitest.SendString(m, "z"), nil)
+					return m
+				},
+			},
+			want: want{
+				model: func(m body.Model) {
+					assert.Equal(t, false, m.Completing())
+					assert.Equal(t, "call Rez", m.RawValue())
+				},
+			},
+		},
+		{
+			name: "complete_escape",
+			args: args{
+				height: 8,
+				state: func(s *commit.State) {
+					s.Repository.Worktree = testWorktree()
+				},
+				model: func(m body.Model) body.Model {
+					m.Focus()
+					m, _ = body.ToModel(m.Update(nil))
+					m, _ = body.ToModel(uitest.SendString(m, "call Re"), nil)
+					m.Complete()
+					m, _ = body.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEsc}))
+					return m
+				},
+			},
+			want: want{
+				model: func(m body.Model) {
+					assert.Equal(t, false, m.Completing())
+					assert.Equal(t, "call Re", m.RawValue())
+				},
+			},
+		},
+		{
+			name: "complete_empty",
+			args: args{
+				height: 8,
+				state: func(s *commit.State) {
+					s.Repository.Worktree = testWorktree()
+				},
+				model: func(m body.Model) body.Model {
+					m.Focus()
+					m, _ = body.ToModel(m.Update(nil))
+					m, _ = body.ToModel(uitest.SendString(m, "call "), nil)
+					m.Complete()
+					return m
+				},
+			},
+			want: want{
+				model: func(m body.Model) {
+					assert.Equal(t, false, m.Completing())
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -205,6 +452,10 @@ func TestModel(t *testing.T) {
 				Theme: theme.New(theme.Default(config.ColourAdaptive)),
 			}
 
+			if tt.args.state != nil {
+				tt.args.state(&c)
+			}
+
 			m := body.New(&c, tt.args.height)
 
 			if tt.args.model != nil {
@@ -220,3 +471,14 @@ func TestModel(t *testing.T) {
 		})
 	}
 }
+
+func testWorktree() repository.Worktree {
+	return repository.Worktree{
+		Status: git.Status{
+			"internal/ui/body/body.go": &git.FileStatus{Staging: git.Modified},
+		},
+		Differ: func() string {
+			return "+func (m *Model) ReplaceWord(str string) {\n+\tm.replace(start, end, str)\n"
+		},
+	}
+}
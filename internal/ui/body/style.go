@@ -15,6 +15,7 @@ type Styles struct {
 	textAreaFocusedText lipgloss.Style
 	textAreaBlurredText lipgloss.Style
 	textAreaCursorStyle lipgloss.Style
+	misspelled          lipgloss.Style
 }
 
 func defaultStyles(th theme.Theme) Styles {
@@ -50,5 +51,9 @@ func defaultStyles(th theme.Theme) Styles {
 	s.textAreaCursorStyle = lipgloss.NewStyle().
 		Foreground(clr.TextAreaCursorStyle)
 
+	s.misspelled = lipgloss.NewStyle().
+		Foreground(colour.New(th).Spelling().Misspelled).
+		Underline(true)
+
 	return s
 }
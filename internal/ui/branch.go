@@ -0,0 +1,54 @@
+package ui
+
+import (
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/ui/prompt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+const branchTitle = "New branch:"
+
+// openBranch prompts for the name of the branch to commit to. The name
+// chosen earlier is kept, otherwise it is suggested from the summary.
+func (m *Model) openBranch() tea.Cmd {
+	name := m.models.info.NewBranch
+	if name == "" {
+		name = commit.BranchName(m.models.header.Summary())
+	}
+
+	return m.models.branch.Open(name)
+}
+
+// onBranchKeyPress handles keys while the prompt is shown. Enter sets the
+// branch and escape closes the prompt. An empty name commits to the current
+// branch.
+func (m Model) onBranchKeyPress(msg tea.KeyMsg) keyResponse {
+	switch msg.String() {
+	case "enter":
+		m.models.info.NewBranch = m.models.branch.Value()
+		m.models.branch.Close()
+	case "esc":
+		m.models.branch.Close()
+	default:
+		var cmd tea.Cmd
+
+		m.models.branch, cmd = prompt.ToModel(m.models.branch.Update(msg))
+
+		return keyResponse{model: m, cmd: cmd, end: true}
+	}
+
+	return keyResponse{model: m, nilMsg: true}
+}
+
+// infoView draws the branch prompt below the information when it is shown.
+func (m Model) infoView() string {
+	view := m.models.info.View()
+
+	if !m.models.branch.Active() {
+		return view
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Top, view, m.models.branch.View())
+}
@@ -3,6 +3,8 @@ package colour
 import (
 	"fmt"
 	"image/color"
+	"reflect"
+	"strings"
 
 	"github.com/mikelorant/committed/internal/theme"
 
@@ -81,6 +83,7 @@ type info struct {
 	AuthorAngledBracket lipgloss.TerminalColor
 	AuthorText          lipgloss.TerminalColor
 	AuthorValue         lipgloss.TerminalColor
+	Warning             lipgloss.TerminalColor
 	DateText            lipgloss.TerminalColor
 	DateValue           lipgloss.TerminalColor
 }
@@ -141,15 +144,30 @@ type shortcut struct {
 	AngleBracket lipgloss.TerminalColor
 }
 
+type spelling struct {
+	Misspelled         lipgloss.TerminalColor
+	Boundary           lipgloss.TerminalColor
+	Title              lipgloss.TerminalColor
+	Suggestion         lipgloss.TerminalColor
+	SuggestionSelected lipgloss.TerminalColor
+}
+
+type status struct {
+	Mode  lipgloss.TerminalColor
+	Error lipgloss.TerminalColor
+}
+
 type Colour struct {
-	registry *tint.Registry
+	registry  *tint.Registry
+	overrides map[string]theme.Components
 }
 
 type Msg int
 
 func New(th theme.Theme) *Colour {
 	return &Colour{
-		registry: th.Registry,
+		registry:  th.Registry,
+		overrides: th.Overrides,
 	}
 }
 
@@ -157,7 +175,7 @@ func New(th theme.Theme) *Colour {
 func (c *Colour) Body() body {
 	clr := c.registry
 
-	return body{
+	b := body{
 		Boundary:            ToAdaptive(clr.BrightBlack()),
 		FocusBoundary:       clr.Fg(),
 		TextAreaPlaceholder: ToAdaptive(clr.BrightBlack()),
@@ -166,13 +184,17 @@ func (c *Colour) Body() body {
 		TextAreaBlurredText: clr.Fg(),
 		TextAreaCursorStyle: clr.Fg(),
 	}
+
+	c.override("body", &b)
+
+	return b
 }
 
 //nolint:revive
 func (c *Colour) FilterList() filterlist {
 	clr := c.registry
 
-	return filterlist{
+	f := filterlist{
 		Boundary:                  ToAdaptive(clr.BrightBlack()),
 		FocusBoundary:             clr.Fg(),
 		ListNormalTitle:           clr.Fg(),
@@ -186,22 +208,30 @@ func (c *Colour) FilterList() filterlist {
 		TextInputPlaceholderStyle: ToAdaptive(clr.BrightBlack()),
 		TextInputCursorStyle:      clr.Fg(),
 	}
+
+	c.override("filterlist", &f)
+
+	return f
 }
 
 //nolint:revive
 func (c *Colour) Footer() footer {
 	clr := c.registry
 
-	return footer{
+	f := footer{
 		View: clr.Fg(),
 	}
+
+	c.override("footer", &f)
+
+	return f
 }
 
 //nolint:revive
 func (c *Colour) Header() header {
 	clr := c.registry
 
-	return header{
+	h := header{
 		EmojiBoundary:                ToAdaptive(clr.BrightBlack()),
 		EmojiFocusBoundary:           clr.Fg(),
 		SummaryBoundary:              ToAdaptive(clr.BrightBlack()),
@@ -223,23 +253,31 @@ func (c *Colour) Header() header {
 		CommitTypeNew:                ToAdaptive(clr.Green()),
 		CommitTypeAmend:              ToAdaptive(clr.Yellow()),
 	}
+
+	c.override("header", &h)
+
+	return h
 }
 
 //nolint:revive
 func (c *Colour) Help() help {
 	clr := c.registry
 
-	return help{
+	h := help{
 		Boundary: clr.Fg(),
 		Viewport: clr.Fg(),
 	}
+
+	c.override("help", &h)
+
+	return h
 }
 
 //nolint:revive
 func (c *Colour) Info() info {
 	clr := c.registry
 
-	return info{
+	i := info{
 		HashText:            ToAdaptive(clr.Yellow()),
 		HashValue:           ToAdaptive(clr.Yellow()),
 		BranchHead:          ToAdaptive(clr.BrightCyan()),
@@ -251,25 +289,34 @@ func (c *Colour) Info() info {
 		AuthorAngledBracket: clr.Fg(),
 		AuthorText:          clr.Fg(),
 		AuthorValue:         clr.Fg(),
+		Warning:             ToAdaptive(clr.BrightYellow()),
 		DateText:            clr.Fg(),
 		DateValue:           clr.Fg(),
 	}
+
+	c.override("info", &i)
+
+	return i
 }
 
 //nolint:revive
 func (c *Colour) Message() message {
 	clr := c.registry
 
-	return message{
+	m := message{
 		Message: clr.Fg(),
 	}
+
+	c.override("message", &m)
+
+	return m
 }
 
 //nolint:revive
 func (c *Colour) Option() option {
 	clr := c.registry
 
-	return option{
+	o := option{
 		SectionBoundary:         ToAdaptive(clr.BrightBlack()),
 		SectionBoundaryFocus:    clr.Fg(),
 		SettingBoundary:         ToAdaptive(clr.BrightBlack()),
@@ -281,13 +328,17 @@ func (c *Colour) Option() option {
 		ThemeListBoundary:       ToAdaptive(clr.BrightBlack()),
 		ThemeListBoundaryFocus:  clr.Fg(),
 	}
+
+	c.override("option", &o)
+
+	return o
 }
 
 //nolint:revive
 func (c *Colour) OptionSection() optionSection {
 	clr := c.registry
 
-	return optionSection{
+	o := optionSection{
 		Category:         clr.Fg(),
 		CategorySelected: ToAdaptive(clr.BrightWhite()),
 		CategorySpacer:   clr.Fg(),
@@ -298,13 +349,17 @@ func (c *Colour) OptionSection() optionSection {
 		SettingPrompt:    clr.Fg(),
 		SettingJoiner:    clr.Fg(),
 	}
+
+	c.override("optionSection", &o)
+
+	return o
 }
 
 //nolint:revive
 func (c *Colour) OptionSetting() optionSetting {
 	clr := c.registry
 
-	return optionSetting{
+	o := optionSetting{
 		Setting:              clr.Fg(),
 		SettingSelected:      ToAdaptive(clr.BrightWhite()),
 		SettingTitle:         clr.Fg(),
@@ -314,13 +369,17 @@ func (c *Colour) OptionSetting() optionSetting {
 		SettingSquareEmpty:   clr.Fg(),
 		SettingSquareFilled:  ToAdaptive(clr.Cyan()),
 	}
+
+	c.override("optionSetting", &o)
+
+	return o
 }
 
 //nolint:revive
 func (c *Colour) OptionTheme() optionTheme {
 	clr := c.registry
 
-	return optionTheme{
+	o := optionTheme{
 		Title:         ToAdaptive(clr.BrightBlack()),
 		TitleFocus:    clr.Fg(),
 		TitleLabel:    clr.Fg(),
@@ -328,18 +387,82 @@ func (c *Colour) OptionTheme() optionTheme {
 		Boundary:      ToAdaptive(clr.BrightBlack()),
 		BoundaryFocus: clr.Fg(),
 	}
+
+	c.override("optionTheme", &o)
+
+	return o
 }
 
 //nolint:revive
 func (c *Colour) Shortcut() shortcut {
 	clr := c.registry
 
-	return shortcut{
+	s := shortcut{
 		Key:          ToAdaptive(clr.Cyan()),
 		Label:        ToAdaptive(clr.Green()),
 		Plus:         clr.Fg(),
 		AngleBracket: clr.Fg(),
 	}
+
+	c.override("shortcut", &s)
+
+	return s
+}
+
+//nolint:revive
+func (c *Colour) Spelling() spelling {
+	clr := c.registry
+
+	s := spelling{
+		Misspelled:         ToAdaptive(clr.Red()),
+		Boundary:           clr.Fg(),
+		Title:              ToAdaptive(clr.BrightBlack()),
+		Suggestion:         clr.Fg(),
+		SuggestionSelected: ToAdaptive(clr.Cyan()),
+	}
+
+	c.override("spelling", &s)
+
+	return s
+}
+
+//nolint:revive
+func (c *Colour) Status() status {
+	clr := c.registry
+
+	s := status{
+		Mode:  ToAdaptive(clr.Yellow()),
+		Error: ToAdaptive(clr.BrightRed()),
+	}
+
+	c.override("status", &s)
+
+	return s
+}
+
+func (c *Colour) override(component string, v any) {
+	comps, ok := c.overrides[c.registry.ID()]
+	if !ok {
+		return
+	}
+
+	rv := reflect.ValueOf(v).Elem()
+
+	for key, clr := range comps {
+		name, field, ok := strings.Cut(key, ".")
+		if !ok || !strings.EqualFold(name, component) {
+			continue
+		}
+
+		f := rv.FieldByNameFunc(func(n string) bool {
+			return strings.EqualFold(n, field)
+		})
+		if !f.IsValid() {
+			continue
+		}
+
+		f.Set(reflect.ValueOf(lipgloss.Color(clr)))
+	}
 }
 
 func ToAdaptive(clr color.Color) lipgloss.AdaptiveColor {
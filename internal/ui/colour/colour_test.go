@@ -86,6 +86,7 @@ type info struct {
 	AuthorAngledBracket Colour
 	AuthorText          Colour
 	AuthorValue         Colour
+	Warning             Colour
 	DateText            Colour
 	DateValue           Colour
 }
@@ -142,6 +143,19 @@ type shortcut struct {
 	AngleBracket Colour
 }
 
+type spelling struct {
+	Misspelled         Colour
+	Boundary           Colour
+	Title              Colour
+	Suggestion         Colour
+	SuggestionSelected Colour
+}
+
+type status struct {
+	Mode  Colour
+	Error Colour
+}
+
 func TestBody(t *testing.T) {
 	t.Parallel()
 
@@ -372,6 +386,7 @@ func TestInfo(t *testing.T) {
 				AuthorAngledBracket: Colour{Dark: "#bbbbbb"},
 				AuthorText:          Colour{Dark: "#bbbbbb"},
 				AuthorValue:         Colour{Dark: "#bbbbbb"},
+				Warning:             Colour{Dark: "#ffff55", Light: "#5555ff"},
 				DateText:            Colour{Dark: "#bbbbbb"},
 				DateValue:           Colour{Dark: "#bbbbbb"},
 			},
@@ -397,6 +412,7 @@ func TestInfo(t *testing.T) {
 			assert.Equal(t, tt.info.AuthorAngledBracket, toColour(clr.AuthorAngledBracket), "AuthorAngledBracket")
 			assert.Equal(t, tt.info.AuthorText, toColour(clr.AuthorText), "AuthorText")
 			assert.Equal(t, tt.info.AuthorValue, toColour(clr.AuthorValue), "AuthorValue")
+			assert.Equal(t, tt.info.Warning, toColour(clr.Warning), "Warning")
 			assert.Equal(t, tt.info.DateText, toColour(clr.DateText), "DateText")
 			assert.Equal(t, tt.info.DateValue, toColour(clr.DateValue), "DateValue")
 		})
@@ -627,6 +643,154 @@ func TestShortcut(t *testing.T) {
 	}
 }
 
+func TestSpelling(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name     string
+		spelling spelling
+	}{
+		{
+			name: "Spelling",
+			spelling: spelling{
+				Misspelled:         Colour{Dark: "#bb0000", Light: "#00bbbb"},
+				Boundary:           Colour{Dark: "#bbbbbb"},
+				Title:              Colour{Dark: "#555555", Light: "#555555"},
+				Suggestion:         Colour{Dark: "#bbbbbb"},
+				SuggestionSelected: Colour{Dark: "#00bbbb", Light: "#bb0000"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			clr := colour.New(theme.New(theme.Default(config.ColourAdaptive))).Spelling()
+
+			assert.Equal(t, tt.spelling.Misspelled, toColour(clr.Misspelled), "Misspelled")
+			assert.Equal(t, tt.spelling.Boundary, toColour(clr.Boundary), "Boundary")
+			assert.Equal(t, tt.spelling.Title, toColour(clr.Title), "Title")
+			assert.Equal(t, tt.spelling.Suggestion, toColour(clr.Suggestion), "Suggestion")
+			assert.Equal(t, tt.spelling.SuggestionSelected, toColour(clr.SuggestionSelected), "SuggestionSelected")
+		})
+	}
+}
+
+func TestStatus(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name   string
+		status status
+	}{
+		{
+			name: "Status",
+			status: status{
+				Mode:  Colour{Dark: "#bbbb00", Light: "#0000bb"},
+				Error: Colour{Dark: "#ff5555", Light: "#55ffff"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			clr := colour.New(theme.New(theme.Default(config.ColourAdaptive))).Status()
+
+			assert.Equal(t, tt.status.Mode, toColour(clr.Mode), "Mode")
+			assert.Equal(t, tt.status.Error, toColour(clr.Error), "Error")
+		})
+	}
+}
+
+func TestOverride(t *testing.T) {
+	t.Parallel()
+
+	type want struct {
+		counterWarning Colour
+		counterHigh    Colour
+		key            Colour
+	}
+
+	tests := []struct {
+		name       string
+		components map[string]string
+		want       want
+	}{
+		{
+			name: "none",
+			want: want{
+				counterWarning: Colour{Dark: "#bbbb00", Light: "#0000bb"},
+				counterHigh:    Colour{Dark: "#ff5555", Light: "#55ffff"},
+				key:            Colour{Dark: "#00bbbb", Light: "#bb0000"},
+			},
+		},
+		{
+			name: "component",
+			components: map[string]string{
+				"header.CounterWarning": "#ff0000",
+			},
+			want: want{
+				counterWarning: Colour{Dark: "#ff0000"},
+				counterHigh:    Colour{Dark: "#ff5555", Light: "#55ffff"},
+				key:            Colour{Dark: "#00bbbb", Light: "#bb0000"},
+			},
+		},
+		{
+			name: "case_insensitive",
+			components: map[string]string{
+				"Header.counterHigh": "#ff0000",
+				"shortcut.key":       "#00ff00",
+			},
+			want: want{
+				counterWarning: Colour{Dark: "#bbbb00", Light: "#0000bb"},
+				counterHigh:    Colour{Dark: "#ff0000"},
+				key:            Colour{Dark: "#00ff00"},
+			},
+		},
+		{
+			name: "invalid",
+			components: map[string]string{
+				"header":         "#ff0000",
+				"header.Invalid": "#ff0000",
+				"invalid.Key":    "#ff0000",
+			},
+			want: want{
+				counterWarning: Colour{Dark: "#bbbb00", Light: "#0000bb"},
+				counterHigh:    Colour{Dark: "#ff5555", Light: "#55ffff"},
+				key:            Colour{Dark: "#00bbbb", Light: "#bb0000"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			ths := []config.CustomTheme{
+				{ID: "builtin_dark", Components: tt.components},
+			}
+
+			th := theme.New(theme.Custom(theme.Default(config.ColourDark), ths))
+
+			hdr := colour.New(th).Header()
+			sc := colour.New(th).Shortcut()
+
+			assert.Equal(t, tt.want.counterWarning, toColour(hdr.CounterWarning), "CounterWarning")
+			assert.Equal(t, tt.want.counterHigh, toColour(hdr.CounterHigh), "CounterHigh")
+			assert.Equal(t, tt.want.key, toColour(sc.Key), "Key")
+		})
+	}
+}
+
 func toColour(clr lipgloss.TerminalColor) Colour {
 	switch clr := clr.(type) {
 	case lipgloss.AdaptiveColor:
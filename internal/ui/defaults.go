@@ -10,7 +10,7 @@ func (m *Model) defaults(cfg config.Config) {
 	m.defaultEmojiType(cfg.Commit.EmojiType)
 	m.defaultFocus(cfg.View.Focus)
 	m.defaultSignoff(cfg.Commit.Signoff)
-	m.defaultTheme(cfg.View.Theme, cfg.View.Colour)
+	m.defaultTheme(cfg.View.Theme, cfg.View.Colour, cfg.Themes)
 }
 
 func (m *Model) defaultEmojiType(et config.EmojiType) {
@@ -34,8 +34,8 @@ func (m *Model) defaultSignoff(signoff bool) {
 	m.signoff = signoff
 }
 
-func (m *Model) defaultTheme(th string, clr config.Colour) {
-	t := theme.New(theme.Default(clr))
+func (m *Model) defaultTheme(th string, clr config.Colour, ths []config.CustomTheme) {
+	t := theme.New(theme.Custom(theme.Default(clr), ths))
 	t.Set(th)
 
 	m.state.Theme = t
@@ -56,11 +56,17 @@ func defaultAmendSave(st *commit.State) savedState {
 }
 
 func defaultHookEditorSave(st *commit.State) savedState {
-	msg := st.File.Message
+	return defaultMessageSave(st, st.File.Message)
+}
+
+func defaultRewordSave(st *commit.State) savedState {
+	return defaultMessageSave(st, st.Reword.Message)
+}
 
+func defaultMessageSave(st *commit.State, msg string) savedState {
 	s := savedState{
-		summary: commit.TrimComments(commit.MessageToSummary(msg)),
-		body:    commit.TrimComments(commit.MessageToBody(msg)),
+		summary: commit.MessageToSummary(msg),
+		body:    commit.MessageToBody(msg),
 	}
 
 	if e := commit.MessageToEmoji(st.Emojis, msg); e.Valid {
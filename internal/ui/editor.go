@@ -0,0 +1,122 @@
+package ui
+
+import (
+	"fmt"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/commit"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// EditorMsg is sent when the external editor exits with the edited message.
+type EditorMsg struct {
+	Message string
+	Err     error
+}
+
+const (
+	editorDefault = "vi"
+	editorPattern = "committed-*.txt"
+	editorSelf    = "committed"
+)
+
+// Environment variables checked for the editor in order of precedence.
+var editorEnvs = []string{"GIT_EDITOR", "VISUAL", "EDITOR"}
+
+func (m Model) openEditor() tea.Cmd {
+	fh, err := os.CreateTemp("", editorPattern)
+	if err != nil {
+		return editorError(fmt.Errorf("unable to create editor file: %w", err))
+	}
+	defer fh.Close()
+
+	if _, err := fh.WriteString(m.editorMessage()); err != nil {
+		os.Remove(fh.Name())
+
+		return editorError(fmt.Errorf("unable to write editor file: %w", err))
+	}
+
+	name := fh.Name()
+
+	// The editor is run by the shell to support arguments in the variable.
+	cmd := exec.Command("sh", "-c", editor()+` "$@"`, "editor", name)
+
+	return tea.ExecProcess(cmd, func(err error) tea.Msg {
+		defer os.Remove(name)
+
+		if err != nil {
+			return EditorMsg{Err: fmt.Errorf("unable to run editor: %w", err)}
+		}
+
+		data, err := os.ReadFile(name)
+		if err != nil {
+			return EditorMsg{Err: fmt.Errorf("unable to read editor file: %w", err)}
+		}
+
+		return EditorMsg{Message: string(data)}
+	})
+}
+
+func (m Model) editorMessage() string {
+	subject := commit.EmojiSummaryToSubject(m.models.header.Emoji.Character, m.models.header.Summary())
+
+	body := m.models.body.RawValue()
+	if body == "" {
+		return subject + "\n"
+	}
+
+	return fmt.Sprintf("%s\n\n%s\n", subject, body)
+}
+
+// restoreEditor replaces the emoji, summary and body with the edited message.
+// The message is left unchanged when the editor fails and the error is shown
+// in the status until the next key press.
+func (m *Model) restoreEditor(msg EditorMsg) {
+	if msg.Err != nil {
+		m.models.status.Error = msg.Err.Error()
+
+		return
+	}
+
+	str := strings.TrimSpace(msg.Message)
+
+	save := savedState{
+		amend:   m.models.header.Amend,
+		summary: commit.MessageToSummary(str),
+		body:    commit.MessageToBody(str),
+	}
+
+	if e := commit.MessageToEmoji(m.state.Emojis, str); e.Valid {
+		save.emoji = e.Emoji
+	}
+
+	m.loadSave(save)
+	m.resetCursor()
+}
+
+// editor returns the editor command skipping committed as it may be
+// configured as the Git editor.
+func editor() string {
+	for _, env := range editorEnvs {
+		e := os.Getenv(env)
+
+		fs := strings.Fields(e)
+		if len(fs) == 0 || filepath.Base(fs[0]) == editorSelf {
+			continue
+		}
+
+		return e
+	}
+
+	return editorDefault
+}
+
+func editorError(err error) tea.Cmd {
+	return func() tea.Msg {
+		return EditorMsg{Err: err}
+	}
+}
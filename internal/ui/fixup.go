@@ -0,0 +1,87 @@
+package ui
+
+import (
+	"fmt"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/keymap"
+	"github.com/mikelorant/committed/internal/repository"
+	"github.com/mikelorant/committed/internal/ui/suggestion"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const (
+	fixupWidth = 60
+	noFixup    = "No fixup"
+)
+
+var fixupTitles = map[commit.Fixup]string{
+	commit.FixupFixup:  "Fixup",
+	commit.FixupSquash: "Squash",
+	commit.FixupAmend:  "Amend",
+}
+
+// openFixup shows the recent commits to choose the target from. The fixup
+// chosen earlier is kept and can be removed with the first item.
+func (m *Model) openFixup() {
+	m.fixup = m.models.info.Fixup
+	if m.fixup == commit.FixupUnset {
+		m.fixup = commit.FixupFixup
+	}
+
+	var targets []repository.Head
+	var items []string
+
+	if m.models.info.Fixup != commit.FixupUnset {
+		targets = append(targets, repository.Head{})
+		items = append(items, noFixup)
+	}
+
+	for _, h := range m.state.Repository.History {
+		targets = append(targets, h)
+		items = append(items, fmt.Sprintf("%.7s %s", h.Hash, commit.MessageToSubject(h.Message)))
+	}
+
+	m.targets = targets
+	m.models.fixup.Title = fixupTitles[m.fixup]
+	m.models.fixup.Open("", items)
+}
+
+// onFixupKeyPress handles keys while the commits are shown. Enter targets the
+// selected commit and the fixup key changes the kind of fixup.
+func (m Model) onFixupKeyPress(msg tea.KeyMsg) keyResponse {
+	switch msg.String() {
+	case "enter":
+		if len(m.targets) > 0 {
+			m.setFixup(m.targets[m.models.fixup.Index()])
+		}
+
+		m.models.fixup.Close()
+	case "esc":
+		m.models.fixup.Close()
+	default:
+		if m.state.KeyMap.Action(msg.String()) == keymap.ActionFixup {
+			m.fixup = m.fixup.Next()
+			m.models.fixup.Title = fixupTitles[m.fixup]
+
+			return keyResponse{model: m, end: true}
+		}
+
+		m.models.fixup, _ = suggestion.ToModel(m.models.fixup.Update(msg))
+
+		return keyResponse{model: m, end: true}
+	}
+
+	return keyResponse{model: m, nilMsg: true}
+}
+
+// setFixup targets the commit. An empty target removes the fixup.
+func (m *Model) setFixup(target repository.Head) {
+	m.models.info.Target = target
+	m.models.info.Fixup = m.fixup
+
+	if target.Hash == "" {
+		m.models.info.Fixup = commit.FixupUnset
+	}
+}
@@ -1,8 +1,6 @@
 package footer
 
 import (
-	"fmt"
-
 	"github.com/mikelorant/committed/internal/commit"
 	"github.com/mikelorant/committed/internal/repository"
 	"github.com/mikelorant/committed/internal/ui/colour"
@@ -19,7 +17,7 @@ type Model struct {
 }
 
 func New(state *commit.State) Model {
-	authors := concatSlice(state.Repository.Users, state.Config.Authors)
+	authors := commit.Authors(state)
 
 	if len(authors) == 0 {
 		authors = []repository.User{{}}
@@ -68,14 +66,9 @@ func (m Model) Value() string {
 }
 
 func (m Model) signoff() string {
-	return fmt.Sprintf("Signed-off-by: %s <%s>", m.Author.Name, m.Author.Email)
+	return commit.Signoff(m.Author)
 }
 
 func ToModel(m tea.Model, c tea.Cmd) (Model, tea.Cmd) {
 	return m.(Model), c
 }
-
-func concatSlice[T any](first []T, second []T) []T {
-	n := len(first)
-	return append(first[:n:n], second...)
-}
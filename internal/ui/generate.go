@@ -0,0 +1,115 @@
+package ui
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/mikelorant/committed/internal/generator"
+	"github.com/mikelorant/committed/internal/ui/suggestion"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// GenerateMsg is sent when the generators finish.
+type GenerateMsg struct {
+	Results []generator.Result
+}
+
+const (
+	generateTitle = "Generate"
+	generateWidth = 60
+)
+
+// generate runs the generators with the staged changes. The diff is read
+// when the generators run rather than when the repository is described.
+func (m Model) generate() tea.Cmd {
+	gs := m.state.Generators
+	in := generator.Input{
+		Repository: m.state.Repository,
+	}
+
+	return func() tea.Msg {
+		in.Diff = in.Repository.Worktree.Diff()
+
+		return GenerateMsg{Results: generator.Run(context.Background(), gs, in)}
+	}
+}
+
+// openGenerate shows the suggestions of the generators. The number of failed
+// generators is shown in the title.
+func (m *Model) openGenerate(msg GenerateMsg) {
+	var ss []generator.Suggestion
+	var failed int
+
+	for _, r := range msg.Results {
+		if r.Err != nil {
+			failed++
+
+			continue
+		}
+
+		ss = append(ss, r.Suggestions...)
+	}
+
+	items := make([]string, len(ss))
+	for i, s := range ss {
+		items[i] = m.generateItem(s)
+	}
+
+	var word string
+	if failed > 0 {
+		word = fmt.Sprintf("%d failed", failed)
+	}
+
+	m.generated = ss
+	m.models.generate.Open(word, items)
+}
+
+// onGenerateKeyPress handles keys while the suggestions are shown. Enter
+// replaces the message with the selected suggestion and focuses the summary
+// to allow it to be edited.
+func (m Model) onGenerateKeyPress(msg tea.KeyMsg) keyResponse {
+	switch msg.String() {
+	case "enter":
+		if len(m.generated) > 0 {
+			m.applyGenerated(m.generated[m.models.generate.Index()])
+			m.focus = summaryComponent
+		}
+
+		m.models.generate.Close()
+	case "esc":
+		m.models.generate.Close()
+	default:
+		m.models.generate, _ = suggestion.ToModel(m.models.generate.Update(msg))
+
+		return keyResponse{model: m, end: true}
+	}
+
+	return keyResponse{model: m, nilMsg: true}
+}
+
+// applyGenerated replaces the message with the suggestion. The emoji is left
+// unchanged when the suggestion has no valid emoji.
+func (m *Model) applyGenerated(s generator.Suggestion) {
+	save := savedState{
+		amend:   m.models.header.Amend,
+		emoji:   m.models.header.Emoji,
+		summary: s.Summary,
+		body:    s.Body,
+	}
+
+	if e := m.state.Emojis.Find(s.Emoji); e.Valid {
+		save.emoji = e.Emoji
+	}
+
+	m.loadSave(save)
+	m.resetCursor()
+}
+
+func (m Model) generateItem(s generator.Suggestion) string {
+	if e := m.state.Emojis.Find(s.Emoji); e.Valid {
+		return fmt.Sprintf("%s %s", e.Emoji.Character, s.Summary)
+	}
+
+	return s.Summary
+}
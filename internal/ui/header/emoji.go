@@ -2,6 +2,7 @@ package header
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 
 	"github.com/mikelorant/committed/internal/config"
@@ -15,6 +16,7 @@ import (
 type listItem struct {
 	emoji         emoji.Emoji
 	compatibility config.Compatibility
+	suggested     bool
 }
 
 type fuzzyItem struct {
@@ -27,6 +29,10 @@ func (i listItem) Title() string {
 	padLen := maxEmojiWidth - uniseg.StringWidth(i.emoji.Character)
 	padding := strings.Repeat(" ", padLen)
 
+	if i.suggested {
+		return fmt.Sprintf("%s%s - %s %s", i.emoji.Character, padding, i.emoji.Description, suggestedMark)
+	}
+
 	return fmt.Sprintf("%s%s - %s", i.emoji.Character, padding, i.emoji.Description)
 }
 
@@ -51,6 +57,12 @@ func WithCompatibility(c config.Compatibility) func(*listItem) {
 	}
 }
 
+func WithSuggested(emojis []emoji.Emoji) func(*listItem) {
+	return func(i *listItem) {
+		i.suggested = slices.Contains(emojis, i.emoji)
+	}
+}
+
 func castToListItems(emojis []emoji.Emoji, opts ...func(*listItem)) []list.Item {
 	res := make([]list.Item, len(emojis))
 	for i, e := range emojis {
@@ -75,3 +87,19 @@ func castToFuzzyItems(emojis []emoji.Emoji) []fuzzy.Item {
 
 	return res
 }
+
+// suggestedFirst moves the suggested emojis to the top of the list.
+func suggestedFirst(emojis, suggested []emoji.Emoji) []emoji.Emoji {
+	if len(suggested) == 0 {
+		return emojis
+	}
+
+	res := slices.Clone(suggested)
+	for _, e := range emojis {
+		if !slices.Contains(suggested, e) {
+			res = append(res, e)
+		}
+	}
+
+	return res
+}
@@ -2,13 +2,16 @@ package header
 
 import (
 	"fmt"
+	"slices"
 
 	"github.com/mikelorant/committed/internal/commit"
 	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/emoji"
 	"github.com/mikelorant/committed/internal/fuzzy"
+	"github.com/mikelorant/committed/internal/spell"
 	"github.com/mikelorant/committed/internal/ui/colour"
 	"github.com/mikelorant/committed/internal/ui/filterlist"
+	"github.com/mikelorant/committed/internal/ui/vim"
 
 	"github.com/charmbracelet/bubbles/list"
 	"github.com/charmbracelet/bubbles/textinput"
@@ -23,7 +26,9 @@ type Model struct {
 	Placeholder   string
 	Emoji         emoji.Emoji
 	Emojis        []emoji.Emoji
+	Suggested     []emoji.Emoji
 	Amend         bool
+	Reword        bool
 
 	focus     bool
 	component component
@@ -33,6 +38,7 @@ type Model struct {
 
 	summaryInput textinput.Model
 	filterList   filterlist.Model
+	editor       *vim.Editor
 }
 
 type component int
@@ -49,23 +55,35 @@ const (
 
 	filterHeight     = 9
 	filterPromptText = "Choose an emoji:"
+
+	suggestedMark = "(suggested)"
 )
 
 func New(state *commit.State) Model {
+	rules := append(slices.Clone(state.EmojiRules), emoji.DefaultRules...)
+	suggested := state.Emojis.Suggest(rules, state.Repository.Worktree.Status)
+
 	m := Model{
 		DefaultHeight: defaultHeight,
 		ExpandHeight:  expandHeight,
-		Emojis:        state.Emojis.Emojis,
+		Emojis:        suggestedFirst(state.Emojis.Emojis, suggested),
+		Suggested:     suggested,
+		Reword:        state.Reword.Hash != "",
 		state:         state,
 		styles:        defaultStyles(state.Theme),
 		summaryInput:  summaryInput(state),
 		filterList:    filterlist.New(state),
 	}
 
-	m.filterList.SetItems(castToListItems(state.Emojis.Emojis, WithCompatibility(state.Config.View.Compatibility)))
+	m.filterList.SetItems(m.listItems())
 	m.filterList.SetHeight(filterHeight)
 	m.filterList.SetPromptText(filterPromptText)
 
+	if state.Config.View.EditMode == config.EditModeVim {
+		e := vim.New(vim.WithSingleLine())
+		m.editor = &e
+	}
+
 	return m
 }
 
@@ -106,6 +124,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.height = m.ExpandHeight
 	}
 
+	if m.focus && m.component == summaryComponent && m.editor != nil && m.summaryInput.Focused() {
+		if msg, ok := msg.(tea.KeyMsg); ok && m.updateEditor(msg) {
+			return m, nil
+		}
+	}
+
 	switch {
 	case m.focus && m.component == summaryComponent && !m.summaryInput.Focused():
 		m.filterList.Blur()
@@ -126,11 +150,11 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	case m.focus && m.component == emojiComponent:
 		ranks := fuzzy.Rank(m.filterList.Filter(), castToFuzzyItems(m.Emojis))
+		all := m.listItems()
 
 		items := make([]list.Item, len(ranks))
 		for i, rank := range ranks {
-			compat := WithCompatibility(m.state.Config.View.Compatibility)
-			items[i] = castToListItems(m.Emojis, compat)[rank]
+			items[i] = all[rank]
 		}
 		m.filterList.SetItems(items)
 	}
@@ -149,6 +173,12 @@ func (m Model) View() string {
 		Render(m.headerRow())
 }
 
+func (m Model) listItems() []list.Item {
+	compat := WithCompatibility(m.state.Config.View.Compatibility)
+
+	return castToListItems(m.Emojis, compat, WithSuggested(m.Suggested))
+}
+
 func (m *Model) Focus() {
 	m.focus = true
 }
@@ -185,10 +215,67 @@ func (m *Model) CursorStartSummary() {
 	m.summaryInput.CursorStart()
 }
 
+// Word returns the word at the cursor of the summary.
+func (m Model) Word() string {
+	w, _, _ := spell.WordAt(m.summaryInput.Value(), m.summaryInput.Position())
+
+	return w
+}
+
+// ReplaceWord replaces the word at the cursor of the summary.
+func (m *Model) ReplaceWord(str string) {
+	_, start, end := spell.WordAt(m.summaryInput.Value(), m.summaryInput.Position())
+	rs := []rune(m.summaryInput.Value())
+
+	m.summaryInput.SetValue(string(rs[:start]) + str + string(rs[end:]))
+	m.summaryInput.SetCursor(start + len([]rune(str)))
+}
+
+// Mode returns the vim mode when modal editing is enabled.
+func (m Model) Mode() string {
+	if m.editor == nil {
+		return ""
+	}
+
+	return m.editor.Mode.String()
+}
+
 func (m *Model) ToggleAmend() {
 	m.Amend = !m.Amend
 }
 
+func (m *Model) updateEditor(msg tea.KeyMsg) bool {
+	t, ok := m.editor.Update(msg.String(), vim.Text{
+		Value: m.summaryInput.Value(),
+		Col:   m.summaryInput.Position(),
+	})
+	if !ok {
+		return false
+	}
+
+	if t.Value != m.summaryInput.Value() {
+		m.summaryInput.SetValue(t.Value)
+	}
+
+	m.summaryInput.SetCursor(t.Col)
+
+	return true
+}
+
+func (m Model) misspelled(str string) string {
+	return m.styles.misspelled.Render(str)
+}
+
+// editingWord is the word at the cursor which is not marked as misspelled
+// while it is being typed.
+func (m Model) editingWord() string {
+	if !m.focus || m.component != summaryComponent {
+		return ""
+	}
+
+	return m.Word()
+}
+
 func (m Model) headerRow() string {
 	if !m.Expand {
 		return lipgloss.NewStyle().Height(m.height).Render(m.subject())
@@ -226,11 +313,16 @@ func (m Model) emoji() string {
 }
 
 func (m Model) summary() string {
+	view := m.summaryInput.View()
+	if m.summaryInput.Value() != "" {
+		view = m.state.Spelling.Mark(view, m.editingWord(), m.misspelled)
+	}
+
 	if (m.focus && m.component == summaryComponent) || !m.state.Config.View.HighlightActive {
-		return m.styles.summaryFocusBoundary.Render(m.summaryInput.View())
+		return m.styles.summaryFocusBoundary.Render(view)
 	}
 
-	return m.styles.summaryBoundary.Render(m.summaryInput.View())
+	return m.styles.summaryBoundary.Render(view)
 }
 
 func (m Model) counter() string {
@@ -254,7 +346,7 @@ func (m Model) readyCommitType() string {
 
 func (m Model) ready() string {
 	switch {
-	case !m.state.Repository.Worktree.IsStaged() && !m.Amend:
+	case !m.state.Repository.Worktree.IsStaged() && !m.Amend && !m.Reword:
 		return m.styles.readyError.String()
 	case len(m.Summary()) < 1:
 		return m.styles.readyIncomplete.String()
@@ -263,8 +355,9 @@ func (m Model) ready() string {
 	return m.styles.readyOK.String()
 }
 
+// commitType shows the kind of commit. Rewording an earlier commit amends it.
 func (m Model) commitType() string {
-	if m.Amend {
+	if m.Amend || m.Reword {
 		return m.styles.commitTypeAmend.String()
 	}
 
@@ -7,11 +7,13 @@ import (
 	"github.com/mikelorant/committed/internal/commit"
 	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/spell"
 	"github.com/mikelorant/committed/internal/theme"
 	"github.com/mikelorant/committed/internal/ui/header"
 	"github.com/mikelorant/committed/internal/ui/uitest"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/go-git/go-git/v5"
 	"github.com/hexops/autogold/v2"
 	"github.com/stretchr/testify/assert"
 )
@@ -94,6 +96,17 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "reword_summary",
+			args: args{
+				model: func(m header.Model) header.Model {
+					m.SetSummary("summary")
+					m.Reword = true
+
+					return m
+				},
+			},
+		},
 		{
 			name: "focus",
 			args: args{
@@ -264,6 +277,54 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "suggested_emoji",
+			args: args{
+				state: func(c *commit.State) {
+					c.Emojis = emoji.New()
+					c.Repository.Worktree.Status = git.Status{
+						"README.md":  &git.FileStatus{Staging: git.Modified},
+						"go.mod":     &git.FileStatus{Staging: git.Modified},
+						"main.go":    &git.FileStatus{Staging: git.Unmodified, Worktree: git.Modified},
+						"LICENSE.md": &git.FileStatus{Staging: git.Untracked, Worktree: git.Untracked},
+					}
+				},
+				model: func(m header.Model) header.Model {
+					m.Focus()
+					m.SelectEmoji()
+					m.Expand = true
+					m, _ = header.ToModel(m.Update(nil))
+					return m
+				},
+			},
+		},
+		{
+			name: "suggested_emoji_select",
+			args: args{
+				state: func(c *commit.State) {
+					c.Emojis = emoji.New()
+					c.EmojiRules = []config.EmojiRule{
+						{Emoji: ":bug:", Paths: []string{"*.go"}},
+					}
+					c.Repository.Worktree.Status = git.Status{
+						"main.go": &git.FileStatus{Staging: git.Modified},
+					}
+				},
+				model: func(m header.Model) header.Model {
+					m.Focus()
+					m.SelectEmoji()
+					m.Expand = true
+					m, _ = header.ToModel(m.Update(nil))
+					m, _ = header.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+			want: want{
+				func(m header.Model) {
+					assert.Equal(t, ":bug:", m.Emoji.Shortcode)
+				},
+			},
+		},
 		{
 			name: "select_emoji_down_up",
 			args: args{
@@ -722,6 +783,52 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "spelling_word",
+			args: args{
+				state: func(c *commit.State) {
+					c.Spelling = spell.New()
+				},
+				model: func(m header.Model) header.Model {
+					m.Focus()
+					m.SelectSummary()
+					m, _ = header.ToModel(m.Update(nil))
+					m, _ = header.ToModel(uitest.SendString(m, "fix teh"), nil)
+					return m
+				},
+			},
+			want: want{
+				func(m header.Model) {
+					assert.Equal(t, "teh", m.Word())
+				},
+			},
+		},
+		{
+			name: "spelling_replace",
+			args: args{
+				state: func(c *commit.State) {
+					c.Spelling = spell.New()
+				},
+				model: func(m header.Model) header.Model {
+					m.Focus()
+					m.SelectSummary()
+					m, _ = header.ToModel(m.Update(nil))
+					m, _ = header.ToModel(uitest.SendString(m, "fix teh bug"), nil)
+					m, _ = header.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyLeft}))
+					m, _ = header.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyLeft}))
+					m, _ = header.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyLeft}))
+					m, _ = header.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyLeft}))
+					m.ReplaceWord("the")
+					return m
+				},
+			},
+			want: want{
+				func(m header.Model) {
+					assert.Equal(t, "fix the bug", m.Summary())
+					assert.Equal(t, "the", m.Word())
+				},
+			},
+		},
 		{
 			name: "config_below",
 			args: args{
@@ -27,6 +27,7 @@ type Styles struct {
 	commitTypeNew                lipgloss.Style
 	commitTypeAmend              lipgloss.Style
 	spacer                       lipgloss.Style
+	misspelled                   lipgloss.Style
 }
 
 const (
@@ -124,6 +125,10 @@ func defaultStyles(th theme.Theme) Styles {
 	s.spacer = lipgloss.NewStyle().
 		Height(1)
 
+	s.misspelled = lipgloss.NewStyle().
+		Foreground(colour.New(th).Spelling().Misspelled).
+		Underline(true)
+
 	return s
 }
 
@@ -1,6 +1,8 @@
 package help
 
 import (
+	"fmt"
+
 	"github.com/mikelorant/committed/internal/commit"
 	"github.com/mikelorant/committed/internal/ui/colour"
 
@@ -76,13 +78,23 @@ func ToModel(m tea.Model, c tea.Cmd) (Model, tea.Cmd) {
 
 func newViewport(w, h int, state *commit.State) viewport.Model {
 	vp := viewport.New(w, h)
-	vp.SetContent(state.Placeholders.Help)
+	vp.SetContent(content(state))
 
 	styleViewport(&vp, state)
 
 	return vp
 }
 
+// content is the help followed by the read-only comment block of the message
+// file.
+func content(state *commit.State) string {
+	if state.File.Comments == "" {
+		return state.Placeholders.Help
+	}
+
+	return fmt.Sprintf("%s\n%s\n", state.Placeholders.Help, state.File.Comments)
+}
+
 func styleViewport(vp *viewport.Model, state *commit.State) {
 	vp.Style = defaultStyles(state.Theme).viewport
 }
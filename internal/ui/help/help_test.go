@@ -18,6 +18,7 @@ func TestModel(t *testing.T) {
 
 	type args struct {
 		content string
+		state   func(s *commit.State)
 		model   func(m help.Model) help.Model
 	}
 
@@ -60,6 +61,15 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "comments",
+			args: args{
+				state: func(s *commit.State) {
+					s.Placeholders.Help = "help\n"
+					s.File.Comments = "# On branch master\n# Changes to be committed:\n#\tmodified:   main.go"
+				},
+			},
+		},
 		{
 			name: "focus",
 			args: args{
@@ -104,8 +114,15 @@ func TestModel(t *testing.T) {
 				Theme: theme.New(theme.Default(config.ColourAdaptive)),
 			}
 
+			if tt.args.state != nil {
+				tt.args.state(state)
+			}
+
 			m := help.New(state)
-			m.SetContent(tt.args.content)
+
+			if tt.args.content != "" {
+				m.SetContent(tt.args.content)
+			}
 
 			if tt.args.model != nil {
 				m = tt.args.model(m)
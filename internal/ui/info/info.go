@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/fuzzy"
 	"github.com/mikelorant/committed/internal/repository"
 	"github.com/mikelorant/committed/internal/ui/colour"
@@ -23,11 +24,24 @@ type Model struct {
 	Hash          string
 	LocalBranch   string
 	RemoteBranch  string
+	RemoteURL     string
+	NewBranch     string
+	Fixup         commit.Fixup
+	Target        repository.Head
+	Reword        bool
+	Detached      bool
+	Protected     bool
+	Ahead         int
+	Behind        int
 	BranchRefs    repository.Refs
 	Remotes       []string
 	Date          string
+	Source        string
 	Author        repository.User
+	Committer     repository.User
+	CommitterDate string
 	Authors       []repository.User
+	AuthorRule    *config.AuthorRule
 
 	focus      bool
 	state      *commit.State
@@ -41,7 +55,7 @@ const (
 )
 
 func New(state *commit.State) Model {
-	authors := commit.SortUsersByDefault(concatSlice(state.Repository.Users, state.Config.Authors)...)
+	authors := commit.Authors(state)
 
 	if len(authors) == 0 {
 		authors = []repository.User{{}}
@@ -51,11 +65,18 @@ func New(state *commit.State) Model {
 		Hash:         state.Placeholders.Hash,
 		LocalBranch:  state.Repository.Branch.Local,
 		RemoteBranch: state.Repository.Branch.Remote,
+		RemoteURL:    state.Repository.Branch.URL,
+		Detached:     state.Repository.Branch.Detached,
+		Protected:    commit.Protected(state.Config.Commit.ProtectedBranches, state.Repository.Branch.Local),
+		Ahead:        state.Repository.Branch.Ahead,
+		Behind:       state.Repository.Branch.Behind,
 		BranchRefs:   state.Repository.Branch.Refs,
 		Remotes:      state.Repository.Remotes,
 		Date:         time.Now().Format(dateTimeFormat),
+		Source:       source(state.File),
 		Author:       authors[0],
 		Authors:      authors,
+		AuthorRule:   state.AuthorRule,
 		state:        state,
 		styles:       defaultStyles(state.Theme),
 		filterList:   filterlist.New(state),
@@ -70,6 +91,26 @@ func New(state *commit.State) Model {
 		m.Date = state.Repository.Head.When.Format(dateTimeFormat)
 	}
 
+	// The message of an earlier commit is replaced by amending it.
+	if r := state.Reword; r.Hash != "" {
+		m.Hash = r.Hash
+		m.Date = r.When.Format(dateTimeFormat)
+		m.Author = r.Author
+		m.Fixup = commit.FixupAmend
+		m.Target = r
+		m.Reword = true
+	}
+
+	if id := state.Identity; !id.AuthorDate.IsZero() {
+		m.Date = id.AuthorDate.Format(dateTimeFormat)
+	}
+
+	if id := state.Identity; !id.CommitterDate.IsZero() {
+		m.CommitterDate = id.CommitterDate.Format(dateTimeFormat)
+	}
+
+	m.Committer = state.Identity.Committer
+
 	return m
 }
 
@@ -142,6 +183,7 @@ func (m Model) infoColumn() string {
 		lipgloss.Top,
 		m.hash(),
 		m.branchRefs(),
+		m.tracking(),
 	)
 
 	it := lipgloss.JoinVertical(
@@ -151,10 +193,34 @@ func (m Model) infoColumn() string {
 		m.date(),
 	)
 
+	if m.Source != "" {
+		it = lipgloss.JoinVertical(lipgloss.Top, it, m.source())
+	}
+
+	if m.Fixup != commit.FixupUnset {
+		it = lipgloss.JoinVertical(lipgloss.Top, it, m.fixup())
+	}
+
+	if !commit.MatchesRule(m.Author, m.AuthorRule) {
+		it = lipgloss.JoinVertical(lipgloss.Top, it, m.authorWarning())
+	}
+
+	if m.Protected && m.NewBranch == "" {
+		it = lipgloss.JoinVertical(lipgloss.Top, it, m.branchWarning())
+	}
+
 	if !m.Expand {
 		return it
 	}
 
+	if m.Committer.Name != "" || m.CommitterDate != "" {
+		it = lipgloss.JoinVertical(lipgloss.Top, it, m.committer(), m.committerDate())
+	}
+
+	if m.RemoteURL != "" {
+		it = lipgloss.JoinVertical(lipgloss.Top, it, m.remoteURL())
+	}
+
 	fl := m.styles.filterListBoundary.Render(m.filterList.View())
 
 	return lipgloss.JoinVertical(
@@ -172,19 +238,33 @@ func (m Model) hash() string {
 }
 
 func (m Model) branchRefs() string {
-	if m.LocalBranch == "" {
+	if m.LocalBranch == "" && !m.Detached {
 		return ""
 	}
 
-	head := m.styles.branchHead
-	local := m.styles.branchLocal.Render(m.LocalBranch)
-
 	left := m.styles.branchGrouping.Render("(")
 	right := m.styles.branchGrouping.Render(")")
 	comma := m.styles.branchGrouping.Render(", ")
 
 	var refs []string
-	refs = append(refs, fmt.Sprintf("%s %s", head, local))
+
+	// The new branch is shown as the head with the current branch still
+	// pointing to the same commit.
+	switch {
+	case m.NewBranch != "":
+		head := m.styles.branchHead
+		refs = append(refs, fmt.Sprintf("%s %s", head, m.styles.branchLocal.Render(m.NewBranch)))
+
+		if m.LocalBranch != "" {
+			refs = append(refs, m.styles.branchLocal.Render(m.LocalBranch))
+		}
+	case m.Detached:
+		refs = append(refs, m.styles.branchDetached.String())
+	default:
+		head := m.styles.branchHead
+		local := m.styles.branchLocal.Render(m.LocalBranch)
+		refs = append(refs, fmt.Sprintf("%s %s", head, local))
+	}
 
 	if m.RemoteBranch != "" {
 		remote := m.styles.branchRemote.Render(m.RemoteBranch)
@@ -200,6 +280,30 @@ func (m Model) branchRefs() string {
 	return fmt.Sprintf("%s%s%s", left, line, right)
 }
 
+// tracking shows the number of commits the branch is ahead and behind the
+// upstream.
+func (m Model) tracking() string {
+	if m.NewBranch != "" {
+		return ""
+	}
+
+	var ts []string
+
+	if m.Ahead > 0 {
+		ts = append(ts, fmt.Sprintf("ahead %d", m.Ahead))
+	}
+
+	if m.Behind > 0 {
+		ts = append(ts, fmt.Sprintf("behind %d", m.Behind))
+	}
+
+	if len(ts) == 0 {
+		return ""
+	}
+
+	return m.styles.branchTracking.Render(fmt.Sprintf("[%s]", strings.Join(ts, ", ")))
+}
+
 func (m Model) author() string {
 	k := m.styles.authorText
 	c := m.styles.colon
@@ -211,6 +315,23 @@ func (m Model) author() string {
 	return fmt.Sprintf("%s%s %s %s%s%s", k, c, n, lb, e, rb)
 }
 
+// authorWarning shows the author expected by the rule matching the
+// repository.
+func (m Model) authorWarning() string {
+	r := m.AuthorRule.Author
+
+	u := r.Email
+	if r.Name != "" {
+		u = fmt.Sprintf("%s <%s>", r.Name, r.Email)
+	}
+
+	return m.styles.warning.Render(fmt.Sprintf("warning: expected author %s", u))
+}
+
+func (m Model) branchWarning() string {
+	return m.styles.warning.Render(fmt.Sprintf("warning: committing to protected branch %s", m.LocalBranch))
+}
+
 func (m Model) date() string {
 	k := m.styles.dateText
 	c := m.styles.colon
@@ -219,6 +340,70 @@ func (m Model) date() string {
 	return fmt.Sprintf("%s%s   %s", k, c, d)
 }
 
+// committer shows the committer override or the author when only the date
+// is overridden.
+func (m Model) committer() string {
+	u := m.Committer
+	if u.Name == "" {
+		u = m.Author
+	}
+
+	k := m.styles.committerText
+	c := m.styles.colon
+	lb := m.styles.authorAngledBracket.Render("<")
+	rb := m.styles.authorAngledBracket.Render(">")
+	n := m.styles.authorValue.Render(u.Name)
+	e := m.styles.authorValue.Render(u.Email)
+
+	return fmt.Sprintf("%s%s %s %s%s%s", k, c, n, lb, e, rb)
+}
+
+// committerDate shows the committer date override or the date when only the
+// committer is overridden.
+func (m Model) committerDate() string {
+	d := m.CommitterDate
+	if d == "" {
+		d = m.Date
+	}
+
+	k := m.styles.committerDateText
+	c := m.styles.colon
+	v := m.styles.dateValue.Render(d)
+
+	return fmt.Sprintf("%s%s %s", k, c, v)
+}
+
+func (m Model) remoteURL() string {
+	k := m.styles.remoteText
+	c := m.styles.colon
+	u := m.styles.remoteValue.Render(m.RemoteURL)
+
+	return fmt.Sprintf("%s%s %s", k, c, u)
+}
+
+func (m Model) source() string {
+	k := m.styles.sourceText
+	c := m.styles.colon
+	s := m.styles.sourceValue.Render(m.Source)
+
+	return fmt.Sprintf("%s%s %s", k, c, s)
+}
+
+// fixup shows the kind of fixup and the commit it targets.
+func (m Model) fixup() string {
+	kind := m.Fixup.String()
+	if m.Reword {
+		kind = "reword"
+	}
+
+	k := m.styles.fixupText.Render(kind)
+	c := m.styles.colon
+	h := m.styles.hashValue.Render(fmt.Sprintf("%.7s", m.Target.Hash))
+	s := m.styles.fixupValue.Render(commit.MessageToSubject(m.Target.Message))
+
+	return fmt.Sprintf("%s%s %s %s", k, c, h, s)
+}
+
 func ToModel(m tea.Model, c tea.Cmd) (Model, tea.Cmd) {
 	return m.(Model), c
 }
@@ -233,11 +418,6 @@ func containsPrefixes(str string, ps []string) bool {
 	return false
 }
 
-func concatSlice[T any](first []T, second []T) []T {
-	n := len(first)
-	return append(first[:n:n], second...)
-}
-
 func refsJoiner(refs []string, style lipgloss.Style, pfx string) []string {
 	fstr := make([]string, len(refs))
 
@@ -247,3 +427,13 @@ func refsJoiner(refs []string, style lipgloss.Style, pfx string) []string {
 
 	return fstr
 }
+
+// source describes where Git prepared the message from. Commits other than
+// the head include the abbreviated hash.
+func source(f commit.File) string {
+	if f.Commit.Hash == "" {
+		return f.Source.String()
+	}
+
+	return fmt.Sprintf("%v %.7v", f.Source, f.Commit.Hash)
+}
@@ -106,6 +106,185 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "identity_author_date",
+			args: args{
+				state: func(c *commit.State) {
+					c.Identity.AuthorDate = time.Date(2021, time.June, 1, 12, 0, 0, 0, time.UTC)
+				},
+			},
+		},
+		{
+			name: "identity_expand",
+			args: args{
+				state: func(c *commit.State) {
+					c.Identity.Committer = repository.User{Name: "Jane Doe", Email: "jane.doe@example.com"}
+					c.Identity.CommitterDate = time.Date(2021, time.June, 2, 12, 0, 0, 0, time.UTC)
+				},
+				model: func(m info.Model) info.Model {
+					m.Expand = true
+					return m
+				},
+			},
+		},
+		{
+			name: "identity_expand_committer",
+			args: args{
+				state: func(c *commit.State) {
+					c.Identity.Committer = repository.User{Name: "Jane Doe", Email: "jane.doe@example.com"}
+				},
+				model: func(m info.Model) info.Model {
+					m.Expand = true
+					return m
+				},
+			},
+		},
+		{
+			name: "source_merge",
+			args: args{
+				state: func(c *commit.State) {
+					c.File.Source = commit.SourceMerge
+				},
+			},
+		},
+		{
+			name: "source_commit",
+			args: args{
+				state: func(c *commit.State) {
+					c.File.Source = commit.SourceCommit
+					c.File.Commit.Hash = "1234567890abcdef1234567890abcdef12345678"
+				},
+			},
+		},
+		{
+			name: "branch_detached",
+			args: args{
+				state: func(c *commit.State) {
+					c.Repository.Branch = repository.Branch{
+						Detached: true,
+						Refs: repository.Refs{
+							Locals:  []string{"master"},
+							Remotes: []string{"origin/master"},
+						},
+					}
+				},
+			},
+		},
+		{
+			name: "fixup",
+			args: args{
+				model: func(m info.Model) info.Model {
+					m.Fixup = commit.FixupFixup
+					m.Target = repository.Head{
+						Hash:    "918c48b83bd081e863dbe1b80f8998f058cd8294",
+						Message: "Add the parser\n\nBody\n",
+					}
+					return m
+				},
+			},
+		},
+		{
+			name: "fixup_amend",
+			args: args{
+				model: func(m info.Model) info.Model {
+					m.Fixup = commit.FixupAmend
+					m.Target = repository.Head{
+						Hash:    "918c48b83bd081e863dbe1b80f8998f058cd8294",
+						Message: "Add the parser\n",
+					}
+					return m
+				},
+			},
+		},
+		{
+			name: "reword",
+			args: args{
+				state: func(c *commit.State) {
+					c.Reword = repository.Head{
+						Hash: "918c48b83bd081e863dbe1b80f8998f058cd8294",
+						Author: repository.User{
+							Name:  "Jane Doe",
+							Email: "jane.doe@example.com",
+						},
+						When:    time.Date(2022, time.January, 1, 1, 0, 0, 0, time.UTC),
+						Message: "Add the parser\n",
+					}
+				},
+			},
+			want: want{
+				model: func(m info.Model) {
+					assert.True(t, m.Reword)
+					assert.Equal(t, commit.FixupAmend, m.Fixup)
+					assert.Equal(t, "Jane Doe", m.Author.Name)
+				},
+			},
+		},
+		{
+			name: "branch_ahead_behind",
+			args: args{
+				state: func(c *commit.State) {
+					c.Repository.Branch = repository.Branch{
+						Local:  "master",
+						Remote: "origin/master",
+						Ahead:  2,
+						Behind: 1,
+					}
+				},
+			},
+		},
+		{
+			name: "branch_behind",
+			args: args{
+				state: func(c *commit.State) {
+					c.Repository.Branch = repository.Branch{
+						Local:  "master",
+						Remote: "origin/master",
+						Behind: 3,
+					}
+				},
+			},
+		},
+		{
+			name: "branch_remote_url_expand",
+			args: args{
+				state: func(c *commit.State) {
+					c.Repository.Branch = repository.Branch{
+						Local:  "master",
+						Remote: "origin/master",
+						URL:    "git@github.com:acme/widget.git",
+					}
+				},
+				model: func(m info.Model) info.Model {
+					m.Expand = true
+					return m
+				},
+			},
+		},
+		{
+			name: "author_rule",
+			args: args{
+				state: func(c *commit.State) {
+					c.Repository.Users = testRepositoryUsers(2)
+					c.AuthorRule = &config.AuthorRule{
+						Author: repository.User{Email: "jdoe@example.org"},
+					}
+				},
+			},
+		},
+		{
+			name: "author_rule_mismatch",
+			args: args{
+				state: func(c *commit.State) {
+					c.AuthorRule = &config.AuthorRule{
+						Author: repository.User{Name: "John Doe", Email: "john.doe@acme.com"},
+					}
+				},
+				model: func(m info.Model) info.Model {
+					m.Author = m.Authors[1]
+					return m
+				},
+			},
+		},
 		{
 			name: "no_users",
 			args: args{
@@ -319,7 +498,9 @@ func TestModel(t *testing.T) {
 			}
 
 			m := info.New(&c)
-			m.Date = time.Date(2022, time.January, 1, 1, 0, 0, 0, time.UTC).Format(dateTimeFormat)
+			if c.Identity.AuthorDate.IsZero() {
+				m.Date = time.Date(2022, time.January, 1, 1, 0, 0, 0, time.UTC).Format(dateTimeFormat)
+			}
 
 			if tt.args.model != nil {
 				m = tt.args.model(m)
@@ -25,15 +25,30 @@ type Styles struct {
 	branchGrouping lipgloss.Style
 	branchRemote   lipgloss.Style
 	branchTag      lipgloss.Style
+	branchDetached lipgloss.Style
+	branchTracking lipgloss.Style
 
 	colon lipgloss.Style
 
 	authorAngledBracket lipgloss.Style
 	authorText          lipgloss.Style
 	authorValue         lipgloss.Style
+	warning             lipgloss.Style
 
 	dateText  lipgloss.Style
 	dateValue lipgloss.Style
+
+	sourceText  lipgloss.Style
+	sourceValue lipgloss.Style
+
+	remoteText  lipgloss.Style
+	remoteValue lipgloss.Style
+
+	fixupText  lipgloss.Style
+	fixupValue lipgloss.Style
+
+	committerText     lipgloss.Style
+	committerDateText lipgloss.Style
 }
 
 func defaultStyles(th theme.Theme) Styles {
@@ -77,6 +92,15 @@ func defaultStyles(th theme.Theme) Styles {
 		Foreground(clr.BranchTag).
 		Bold(true)
 
+	s.branchDetached = lipgloss.NewStyle().
+		Foreground(clr.BranchHead).
+		Bold(true).
+		SetString("HEAD detached")
+
+	s.branchTracking = lipgloss.NewStyle().
+		Foreground(clr.BranchGrouping).
+		MarginLeft(1)
+
 	s.colon = lipgloss.NewStyle().
 		Foreground(clr.Colon).
 		SetString(":")
@@ -91,6 +115,9 @@ func defaultStyles(th theme.Theme) Styles {
 	s.authorValue = lipgloss.NewStyle().
 		Foreground(clr.AuthorValue)
 
+	s.warning = lipgloss.NewStyle().
+		Foreground(clr.Warning)
+
 	s.dateText = lipgloss.NewStyle().
 		Foreground(clr.DateText).
 		SetString("date")
@@ -98,5 +125,33 @@ func defaultStyles(th theme.Theme) Styles {
 	s.dateValue = lipgloss.NewStyle().
 		Foreground(clr.DateValue)
 
+	s.sourceText = lipgloss.NewStyle().
+		Foreground(clr.DateText).
+		SetString("source")
+
+	s.sourceValue = lipgloss.NewStyle().
+		Foreground(clr.DateValue)
+
+	s.remoteText = lipgloss.NewStyle().
+		Foreground(clr.DateText).
+		SetString("remote")
+
+	s.remoteValue = lipgloss.NewStyle().
+		Foreground(clr.DateValue)
+
+	s.fixupText = lipgloss.NewStyle().
+		Foreground(clr.DateText)
+
+	s.fixupValue = lipgloss.NewStyle().
+		Foreground(clr.DateValue)
+
+	s.committerText = lipgloss.NewStyle().
+		Foreground(clr.AuthorText).
+		SetString("committer")
+
+	s.committerDateText = lipgloss.NewStyle().
+		Foreground(clr.DateText).
+		SetString("committed")
+
 	return s
 }
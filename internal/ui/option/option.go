@@ -80,6 +80,8 @@ func (m Model) Init() tea.Cmd {
 
 //nolint:ireturn
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
 	switch m.Panel {
 	case PanelSection:
 		//nolint:gocritic
@@ -101,6 +103,13 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 	case PanelSetting:
+		if m.Editing() {
+			cmd = m.setting.Selected.(*setting.Authors).Update(msg)
+			msg = nil
+
+			break
+		}
+
 		//nolint:gocritic
 		switch msg := msg.(type) {
 		case tea.KeyMsg:
@@ -109,12 +118,16 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				switch p := m.setting.Selected.(type) {
 				case *setting.Radio:
 					p.Next()
+				case *setting.Authors:
+					p.Next()
 				}
 
 			case "up":
 				switch p := m.setting.Selected.(type) {
 				case *setting.Radio:
 					p.Previous()
+				case *setting.Authors:
+					p.Previous()
 				}
 			case "left", "enter", "tab":
 				m.Panel = PanelSection
@@ -122,6 +135,20 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 				switch p := m.setting.Selected.(type) {
 				case *setting.Toggle:
 					p.Enable = !p.Enable
+				case *setting.Authors:
+					p.ToggleDefault()
+				}
+			case "a":
+				if p, ok := m.setting.Selected.(*setting.Authors); ok {
+					cmd = p.Add()
+				}
+			case "e":
+				if p, ok := m.setting.Selected.(*setting.Authors); ok {
+					cmd = p.Edit()
+				}
+			case "d", "delete":
+				if p, ok := m.setting.Selected.(*setting.Authors); ok {
+					p.Remove()
 				}
 			}
 		}
@@ -154,7 +181,8 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	m.setting.SwapPaneSet(m.section.SelectedCategory())
 
-	cmds := make([]tea.Cmd, 3)
+	cmds := make([]tea.Cmd, 4)
+	cmds[3] = cmd
 	m.section, cmds[0] = section.ToModel(m.section.Update(msg))
 	m.setting, cmds[1] = setting.ToModel(m.setting.Update(msg))
 	m.theme, cmds[2] = theme.ToModel(m.theme.Update(msg))
@@ -270,6 +298,16 @@ func (m *Model) ActivePane() setting.Paner {
 	return m.setting.ActivePane()
 }
 
+func (m Model) Editing() bool {
+	if m.Panel != PanelSetting {
+		return false
+	}
+
+	p, ok := m.setting.Selected.(*setting.Authors)
+
+	return ok && p.Editing()
+}
+
 func (m *Model) SectionIndex(c int, s int) {
 	m.section.CatIndex = c
 	m.section.SetIndex = s
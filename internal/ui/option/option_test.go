@@ -5,6 +5,7 @@ import (
 	"testing"
 
 	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/repository"
 	"github.com/mikelorant/committed/internal/theme"
 	"github.com/mikelorant/committed/internal/theme/themetest"
 	"github.com/mikelorant/committed/internal/ui/option"
@@ -224,6 +225,82 @@ func TestModel(t *testing.T) {
 			},
 		},
 
+		// Authors
+		{
+			name: "authors",
+			args: args{
+				model: func(m option.Model) option.Model {
+					m.SetSettings([]section.Setting{
+						{Category: "Authors", Name: "Authors"},
+					})
+
+					m.AddPaneSet("Authors", []setting.Paner{
+						&setting.Authors{
+							Title: "Authors",
+							Authors: []repository.User{
+								{Name: "John Doe", Email: "john.doe@example.com"},
+							},
+						},
+					})
+
+					m, _ = option.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRight}))
+					m, _ = option.ToModel(m.Update(uitest.KeyPress('a')))
+					m, _ = option.ToModel(uitest.SendString(m, "Jane Doe"), nil)
+					m, _ = option.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = option.ToModel(uitest.SendString(m, "jane.doe@example.com"), nil)
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m option.Model) {
+					assert.True(t, m.Editing())
+					assert.Len(t, setting.ToAuthors(m.ActivePane()).Value(), 1)
+				},
+			},
+		},
+		{
+			name: "authors_save",
+			args: args{
+				model: func(m option.Model) option.Model {
+					m.SetSettings([]section.Setting{
+						{Category: "Authors", Name: "Authors"},
+					})
+
+					m.AddPaneSet("Authors", []setting.Paner{
+						&setting.Authors{
+							Title: "Authors",
+							Authors: []repository.User{
+								{Name: "John Doe", Email: "john.doe@example.com"},
+							},
+						},
+					})
+
+					m, _ = option.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRight}))
+					m, _ = option.ToModel(m.Update(uitest.KeyPress('a')))
+					m, _ = option.ToModel(uitest.SendString(m, "Jane Doe"), nil)
+					m, _ = option.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = option.ToModel(uitest.SendString(m, "jane.doe@example.com"), nil)
+					m, _ = option.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = option.ToModel(m.Update(uitest.KeyPress(' ')))
+					m, _ = option.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyUp}))
+					m, _ = option.ToModel(m.Update(uitest.KeyPress('d')))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m option.Model) {
+					want := []repository.User{
+						{Name: "Jane Doe", Email: "jane.doe@example.com", Default: true},
+					}
+
+					assert.False(t, m.Editing())
+					assert.Equal(t, want, setting.ToAuthors(m.ActivePane()).Value())
+				},
+			},
+		},
+
 		// Combined
 		{
 			name: "combined",
@@ -68,7 +68,7 @@ func (m Model) View() string {
 	return lipgloss.NewStyle().
 		MaxWidth(m.Width).
 		MaxHeight(m.Height).
-		Render(m.renderSection())
+		Render(m.scroll(m.renderSection()))
 }
 
 func (m Model) SelectedCategory() string {
@@ -135,6 +135,38 @@ func (m *Model) Previous() {
 	}
 }
 
+// scroll removes lines from the top when the selected setting is below the
+// height of the section.
+func (m Model) scroll(str string) string {
+	lines := strings.Split(str, "\n")
+
+	offset := m.selectedLine() - m.Height + 1
+	if offset <= 0 {
+		return str
+	}
+
+	return strings.Join(lines[offset:], "\n")
+}
+
+// selectedLine is the line of the selected category or setting.
+func (m Model) selectedLine() int {
+	var line int
+
+	for idx, c := range Categories(m.Settings) {
+		if idx == m.CatIndex {
+			if len(Settings(c, m.Settings)) == 0 {
+				return line
+			}
+
+			return line + m.SetIndex + 1
+		}
+
+		line += len(Settings(c, m.Settings)) + 2
+	}
+
+	return line
+}
+
 func (m Model) renderSection() string {
 	var str []string
 
@@ -179,6 +179,38 @@ func TestModel(t *testing.T) {
 				setting:  "1",
 			},
 		},
+		{
+			name: "scroll",
+			args: args{
+				model: func(m section.Model) section.Model {
+					m.Height = 10
+					m.CatIndex = 3
+					m.SetIndex = 1
+
+					return m
+				},
+			},
+			want: want{
+				category: "Forth",
+				setting:  "2",
+			},
+		},
+		{
+			name: "scroll_unneeded",
+			args: args{
+				model: func(m section.Model) section.Model {
+					m.Height = 10
+					m.CatIndex = 2
+					m.SetIndex = 0
+
+					return m
+				},
+			},
+			want: want{
+				category: "Third",
+				setting:  "1",
+			},
+		},
 		{
 			name: "invalid",
 			args: args{
@@ -0,0 +1,268 @@
+package setting
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/repository"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+type Authors struct {
+	Title   string
+	Authors []repository.User
+	Index   int
+
+	focus   bool
+	editing bool
+	adding  bool
+	field   int
+	inputs  []textinput.Model
+}
+
+const (
+	authorNameField = iota
+	authorEmailField
+)
+
+const authorsHint = "a add  e edit  d delete  space default"
+
+func (a *Authors) Render(styles Styles) string {
+	var str []string
+
+	switch a.focus {
+	case true:
+		str = append(str, styles.settingTitleSelected.Render(a.Title))
+	default:
+		str = append(str, styles.settingTitle.Render(a.Title))
+	}
+
+	for idx, author := range a.Authors {
+		dot := styles.settingSquareEmpty
+		if author.Default {
+			dot = styles.settingSquareFilled
+		}
+
+		val := fmt.Sprintf("%s <%s>", author.Name, author.Email)
+
+		if idx == a.Index && a.focus {
+			str = append(str, fmt.Sprintf("%v %v", dot, styles.settingSelected.Render(val)))
+			continue
+		}
+
+		str = append(str, fmt.Sprintf("%v %v", dot, styles.setting.Render(val)))
+	}
+
+	if len(a.Authors) == 0 {
+		str = append(str, styles.setting.Render("No authors"))
+	}
+
+	if a.editing {
+		str = append(str, "")
+
+		for i := range a.inputs {
+			a.inputs[i].PromptStyle = styles.settingTitle
+			a.inputs[i].TextStyle = styles.settingSelected
+			a.inputs[i].PlaceholderStyle = styles.setting
+			a.inputs[i].Cursor.Style = styles.settingSelected
+
+			str = append(str, a.inputs[i].View())
+		}
+	}
+
+	if a.focus {
+		str = append(str, "", styles.setting.Render(authorsHint))
+	}
+
+	return strings.Join(str, "\n")
+}
+
+func (a *Authors) Focus() {
+	a.focus = true
+}
+
+func (a *Authors) Blur() {
+	a.focus = false
+	a.Cancel()
+}
+
+func (a *Authors) Value() []repository.User {
+	us := make([]repository.User, len(a.Authors))
+	copy(us, a.Authors)
+
+	return us
+}
+
+func (a *Authors) Next() {
+	if a.Index >= len(a.Authors)-1 {
+		a.Index = 0
+		return
+	}
+
+	a.Index++
+}
+
+func (a *Authors) Previous() {
+	if a.Index <= 0 {
+		a.Index = max(len(a.Authors)-1, 0)
+		return
+	}
+
+	a.Index--
+}
+
+func (a *Authors) Add() tea.Cmd {
+	a.adding = true
+
+	return a.edit(repository.User{})
+}
+
+func (a *Authors) Edit() tea.Cmd {
+	if len(a.Authors) == 0 {
+		return nil
+	}
+
+	a.adding = false
+
+	return a.edit(a.Authors[a.Index])
+}
+
+func (a *Authors) Remove() {
+	if len(a.Authors) == 0 {
+		return
+	}
+
+	a.Authors = append(a.Authors[:a.Index:a.Index], a.Authors[a.Index+1:]...)
+
+	if a.Index >= len(a.Authors) {
+		a.Index = max(len(a.Authors)-1, 0)
+	}
+}
+
+func (a *Authors) ToggleDefault() {
+	if len(a.Authors) == 0 {
+		return
+	}
+
+	enable := !a.Authors[a.Index].Default
+
+	for i := range a.Authors {
+		a.Authors[i].Default = false
+	}
+
+	a.Authors[a.Index].Default = enable
+}
+
+func (a *Authors) Editing() bool {
+	return a.editing
+}
+
+func (a *Authors) Cancel() {
+	a.editing = false
+	a.adding = false
+	a.inputs = nil
+}
+
+func (a *Authors) Update(msg tea.Msg) tea.Cmd {
+	if !a.editing {
+		return nil
+	}
+
+	if msg, ok := msg.(tea.KeyMsg); ok {
+		switch msg.String() {
+		case "esc":
+			a.Cancel()
+
+			return nil
+		case "tab", "down":
+			return a.selectField(a.field + 1)
+		case "shift+tab", "up":
+			return a.selectField(a.field - 1)
+		case "enter":
+			if a.field < authorEmailField {
+				return a.selectField(a.field + 1)
+			}
+
+			a.save()
+
+			return nil
+		}
+	}
+
+	var cmd tea.Cmd
+
+	a.inputs[a.field], cmd = a.inputs[a.field].Update(msg)
+
+	return cmd
+}
+
+func (a *Authors) Type() Type {
+	return TypeAuthors
+}
+
+func (a *Authors) edit(u repository.User) tea.Cmd {
+	name := textinput.New()
+	name.Prompt = "Name:  "
+	name.Placeholder = "John Doe"
+	name.SetValue(u.Name)
+
+	email := textinput.New()
+	email.Prompt = "Email: "
+	email.Placeholder = "john.doe@example.com"
+	email.SetValue(u.Email)
+
+	a.inputs = []textinput.Model{name, email}
+	a.editing = true
+
+	return a.selectField(authorNameField)
+}
+
+func (a *Authors) selectField(i int) tea.Cmd {
+	switch {
+	case i < authorNameField:
+		i = authorEmailField
+	case i > authorEmailField:
+		i = authorNameField
+	}
+
+	a.field = i
+
+	for j := range a.inputs {
+		a.inputs[j].Blur()
+	}
+
+	return a.inputs[i].Focus()
+}
+
+func (a *Authors) save() {
+	u := repository.User{
+		Name:  strings.TrimSpace(a.inputs[authorNameField].Value()),
+		Email: strings.TrimSpace(a.inputs[authorEmailField].Value()),
+	}
+
+	switch {
+	case u.Name == "":
+		a.selectField(authorNameField)
+		return
+	case u.Email == "":
+		a.selectField(authorEmailField)
+		return
+	}
+
+	switch a.adding {
+	case true:
+		a.Authors = append(a.Authors, u)
+		a.Index = len(a.Authors) - 1
+	default:
+		u.Default = a.Authors[a.Index].Default
+		a.Authors[a.Index] = u
+	}
+
+	a.Cancel()
+}
+
+func ToAuthors(p Paner) *Authors {
+	return p.(*Authors)
+}
@@ -34,6 +34,7 @@ const (
 	TypeNoop
 	TypeRadio
 	TypeToggle
+	TypeAuthors
 )
 
 const (
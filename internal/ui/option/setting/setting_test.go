@@ -5,10 +5,12 @@ import (
 
 	"github.com/mikelorant/committed/internal/commit"
 	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/repository"
 	"github.com/mikelorant/committed/internal/theme"
 	"github.com/mikelorant/committed/internal/ui/option/setting"
 	"github.com/mikelorant/committed/internal/ui/uitest"
 
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/hexops/autogold/v2"
 	"github.com/stretchr/testify/assert"
 )
@@ -262,6 +264,268 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "authors",
+			args: args{
+				panes: []setting.Paner{
+					&setting.Authors{Title: "Authors", Authors: testAuthors()},
+				},
+				model: func(m setting.Model) setting.Model {
+					m.SelectPane("Authors")
+					return m
+				},
+			},
+			want: want{
+				model: func(m setting.Model) {
+					ap := setting.ToAuthors(m.ActivePane())
+					assert.Equal(t, testAuthors(), ap.Value())
+				},
+			},
+		},
+		{
+			name: "authors_empty",
+			args: args{
+				panes: []setting.Paner{
+					&setting.Authors{Title: "Authors"},
+				},
+				model: func(m setting.Model) setting.Model {
+					ap := setting.ToAuthors(m.ActivePane())
+					ap.Next()
+					ap.Remove()
+					ap.ToggleDefault()
+					ap.Edit()
+					return m
+				},
+			},
+			want: want{
+				model: func(m setting.Model) {
+					ap := setting.ToAuthors(m.ActivePane())
+					assert.Empty(t, ap.Value())
+					assert.False(t, ap.Editing())
+				},
+			},
+		},
+		{
+			name: "authors_next",
+			args: args{
+				panes: []setting.Paner{
+					&setting.Authors{Title: "Authors", Authors: testAuthors()},
+				},
+				model: func(m setting.Model) setting.Model {
+					m.SelectPane("Authors")
+					setting.ToAuthors(m.ActivePane()).Next()
+					return m
+				},
+			},
+			want: want{
+				model: func(m setting.Model) {
+					assert.Equal(t, 1, setting.ToAuthors(m.ActivePane()).Index)
+				},
+			},
+		},
+		{
+			name: "authors_previous",
+			args: args{
+				panes: []setting.Paner{
+					&setting.Authors{Title: "Authors", Authors: testAuthors()},
+				},
+				model: func(m setting.Model) setting.Model {
+					m.SelectPane("Authors")
+					setting.ToAuthors(m.ActivePane()).Previous()
+					return m
+				},
+			},
+			want: want{
+				model: func(m setting.Model) {
+					assert.Equal(t, 2, setting.ToAuthors(m.ActivePane()).Index)
+				},
+			},
+		},
+		{
+			name: "authors_default",
+			args: args{
+				panes: []setting.Paner{
+					&setting.Authors{Title: "Authors", Authors: testAuthors()},
+				},
+				model: func(m setting.Model) setting.Model {
+					m.SelectPane("Authors")
+					ap := setting.ToAuthors(m.ActivePane())
+					ap.Next()
+					ap.ToggleDefault()
+					return m
+				},
+			},
+			want: want{
+				model: func(m setting.Model) {
+					ap := setting.ToAuthors(m.ActivePane())
+					assert.False(t, ap.Value()[0].Default)
+					assert.True(t, ap.Value()[1].Default)
+				},
+			},
+		},
+		{
+			name: "authors_default_twice",
+			args: args{
+				panes: []setting.Paner{
+					&setting.Authors{Title: "Authors", Authors: testAuthors()},
+				},
+				model: func(m setting.Model) setting.Model {
+					m.SelectPane("Authors")
+					ap := setting.ToAuthors(m.ActivePane())
+					ap.ToggleDefault()
+					ap.ToggleDefault()
+					return m
+				},
+			},
+			want: want{
+				model: func(m setting.Model) {
+					for _, a := range setting.ToAuthors(m.ActivePane()).Value() {
+						assert.False(t, a.Default)
+					}
+				},
+			},
+		},
+		{
+			name: "authors_remove_last",
+			args: args{
+				panes: []setting.Paner{
+					&setting.Authors{Title: "Authors", Authors: testAuthors()},
+				},
+				model: func(m setting.Model) setting.Model {
+					m.SelectPane("Authors")
+					ap := setting.ToAuthors(m.ActivePane())
+					ap.Previous()
+					ap.Remove()
+					return m
+				},
+			},
+			want: want{
+				model: func(m setting.Model) {
+					ap := setting.ToAuthors(m.ActivePane())
+					assert.Equal(t, testAuthors()[:2], ap.Value())
+					assert.Equal(t, 1, ap.Index)
+				},
+			},
+		},
+		{
+			name: "authors_add",
+			args: args{
+				panes: []setting.Paner{
+					&setting.Authors{Title: "Authors", Authors: testAuthors()},
+				},
+				model: func(m setting.Model) setting.Model {
+					m.SelectPane("Authors")
+					ap := setting.ToAuthors(m.ActivePane())
+					ap.Add()
+					sendString(ap, "Jim Doe")
+					ap.Update(tea.KeyMsg{Type: tea.KeyEnter})
+					sendString(ap, "jim.doe@example.com")
+					ap.Update(tea.KeyMsg{Type: tea.KeyEnter})
+					return m
+				},
+			},
+			want: want{
+				model: func(m setting.Model) {
+					ap := setting.ToAuthors(m.ActivePane())
+					want := append(testAuthors(), repository.User{Name: "Jim Doe", Email: "jim.doe@example.com"})
+					assert.Equal(t, want, ap.Value())
+					assert.Equal(t, 3, ap.Index)
+					assert.False(t, ap.Editing())
+				},
+			},
+		},
+		{
+			name: "authors_add_incomplete",
+			args: args{
+				panes: []setting.Paner{
+					&setting.Authors{Title: "Authors", Authors: testAuthors()},
+				},
+				model: func(m setting.Model) setting.Model {
+					m.SelectPane("Authors")
+					ap := setting.ToAuthors(m.ActivePane())
+					ap.Add()
+					sendString(ap, "Jim Doe")
+					ap.Update(tea.KeyMsg{Type: tea.KeyEnter})
+					ap.Update(tea.KeyMsg{Type: tea.KeyEnter})
+					return m
+				},
+			},
+			want: want{
+				model: func(m setting.Model) {
+					ap := setting.ToAuthors(m.ActivePane())
+					assert.Equal(t, testAuthors(), ap.Value())
+					assert.True(t, ap.Editing())
+				},
+			},
+		},
+		{
+			name: "authors_edit",
+			args: args{
+				panes: []setting.Paner{
+					&setting.Authors{Title: "Authors", Authors: testAuthors()},
+				},
+				model: func(m setting.Model) setting.Model {
+					m.SelectPane("Authors")
+					ap := setting.ToAuthors(m.ActivePane())
+					ap.ToggleDefault()
+					ap.Edit()
+					ap.Update(tea.KeyMsg{Type: tea.KeyTab})
+					sendString(ap, ".au")
+					ap.Update(tea.KeyMsg{Type: tea.KeyEnter})
+					return m
+				},
+			},
+			want: want{
+				model: func(m setting.Model) {
+					ap := setting.ToAuthors(m.ActivePane())
+					want := repository.User{Name: "John Doe", Email: "john.doe@example.com.au", Default: true}
+					assert.Equal(t, want, ap.Value()[0])
+				},
+			},
+		},
+		{
+			name: "authors_edit_cancel",
+			args: args{
+				panes: []setting.Paner{
+					&setting.Authors{Title: "Authors", Authors: testAuthors()},
+				},
+				model: func(m setting.Model) setting.Model {
+					m.SelectPane("Authors")
+					ap := setting.ToAuthors(m.ActivePane())
+					ap.Edit()
+					sendString(ap, "test")
+					ap.Update(tea.KeyMsg{Type: tea.KeyEsc})
+					return m
+				},
+			},
+			want: want{
+				model: func(m setting.Model) {
+					ap := setting.ToAuthors(m.ActivePane())
+					assert.Equal(t, testAuthors(), ap.Value())
+					assert.False(t, ap.Editing())
+				},
+			},
+		},
+		{
+			name: "authors_editing",
+			args: args{
+				panes: []setting.Paner{
+					&setting.Authors{Title: "Authors", Authors: testAuthors()},
+				},
+				model: func(m setting.Model) setting.Model {
+					m.SelectPane("Authors")
+					ap := setting.ToAuthors(m.ActivePane())
+					ap.Next()
+					ap.Edit()
+					return m
+				},
+			},
+			want: want{
+				model: func(m setting.Model) {
+					assert.True(t, setting.ToAuthors(m.ActivePane()).Editing())
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -290,3 +554,17 @@ func TestModel(t *testing.T) {
 		})
 	}
 }
+
+func testAuthors() []repository.User {
+	return []repository.User{
+		{Name: "John Doe", Email: "john.doe@example.com"},
+		{Name: "Jane Doe", Email: "jane.doe@example.com"},
+		{Name: "Joe Bloggs", Email: "joe.bloggs@example.com"},
+	}
+}
+
+func sendString(a *setting.Authors, str string) {
+	for _, r := range str {
+		a.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+	}
+}
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/repository"
 	"github.com/mikelorant/committed/internal/theme"
 	"github.com/mikelorant/committed/internal/ui/option/section"
 	"github.com/mikelorant/committed/internal/ui/option/setting"
@@ -29,12 +30,16 @@ func (m *Model) setOptionSettings() {
 		{Category: "General", Name: "Emoji Selector"},
 		{Category: "General", Name: "Emoji Set"},
 		{Category: "General", Name: "Ignore Global Author"},
+		{Category: "General", Name: "Edit Mode"},
+		{Category: "General", Name: "Spell Check"},
 		{Category: "Theme", Name: "Theme"},
 		{Category: "Visual", Name: "Colour"},
 		{Category: "Visual", Name: "Compatibility"},
 		{Category: "Visual", Name: "Highlight Active"},
 		{Category: "Commit", Name: "Emoji Type"},
 		{Category: "Commit", Name: "Sign-off"},
+		{Category: "Commit", Name: "Reflow"},
+		{Category: "Authors", Name: "Authors"},
 	})
 }
 
@@ -43,6 +48,7 @@ func (m *Model) setOptionPaneSets() {
 	m.VisualPaneSet()
 	m.ThemePaneSet()
 	m.CommitPaneSet()
+	m.AuthorsPaneSet()
 }
 
 func (m *Model) GeneralPaneSet() {
@@ -69,6 +75,15 @@ func (m *Model) GeneralPaneSet() {
 				Title:  "Ignore Global Author",
 				Enable: bool(cfg.View.IgnoreGlobalAuthor),
 			},
+			&setting.Radio{
+				Title:  "Edit Mode",
+				Values: []string{"Default", "Vim"},
+				Index:  cfg.View.EditMode.Index() - 1,
+			},
+			&setting.Toggle{
+				Title:  "Spell Check",
+				Enable: cfg.View.SpellCheck,
+			},
 		},
 	)
 }
@@ -118,6 +133,26 @@ func (m *Model) CommitPaneSet() {
 				Title:  "Sign-off",
 				Enable: bool(cfg.Commit.Signoff),
 			},
+			&setting.Toggle{
+				Title:  "Reflow",
+				Enable: cfg.Commit.Reflow,
+			},
+		},
+	)
+}
+
+func (m *Model) AuthorsPaneSet() {
+	cfg := m.state.Config
+
+	authors := make([]repository.User, len(cfg.Authors))
+	copy(authors, cfg.Authors)
+
+	m.models.option.AddPaneSet("Authors",
+		[]setting.Paner{
+			&setting.Authors{
+				Title:   "Authors",
+				Authors: authors,
+			},
 		},
 	)
 }
@@ -128,6 +163,8 @@ func ToConfig(cfg config.Config, ps map[string][]setting.Paner, th theme.Theme)
 		EmojiSelector:      config.EmojiSelector(ps["General"][1].(*setting.Radio).Index) + 1,
 		EmojiSet:           config.EmojiSet(ps["General"][2].(*setting.Radio).Index) + 1,
 		IgnoreGlobalAuthor: ps["General"][3].(*setting.Toggle).Enable,
+		EditMode:           config.EditMode(ps["General"][4].(*setting.Radio).Index) + 1,
+		SpellCheck:         ps["General"][5].(*setting.Toggle).Enable,
 		Colour:             config.Colour(ps["Visual"][0].(*setting.Radio).Index) + 1,
 		Compatibility:      config.Compatibility(ps["Visual"][1].(*setting.Radio).Index) + 1,
 		HighlightActive:    ps["Visual"][2].(*setting.Toggle).Enable,
@@ -135,13 +172,22 @@ func ToConfig(cfg config.Config, ps map[string][]setting.Paner, th theme.Theme)
 	}
 
 	commit := config.Commit{
-		EmojiType: config.EmojiType(ps["Commit"][0].(*setting.Radio).Index) + 1,
-		Signoff:   ps["Commit"][1].(*setting.Toggle).Enable,
+		EmojiType:         config.EmojiType(ps["Commit"][0].(*setting.Radio).Index) + 1,
+		Signoff:           ps["Commit"][1].(*setting.Toggle).Enable,
+		Reflow:            ps["Commit"][2].(*setting.Toggle).Enable,
+		HookFallback:      cfg.Commit.HookFallback,
+		ProtectedBranches: cfg.Commit.ProtectedBranches,
 	}
 
 	return config.Config{
-		View:    view,
-		Commit:  commit,
-		Authors: cfg.Authors,
+		View:        view,
+		Commit:      commit,
+		Authors:     ps["Authors"][0].(*setting.Authors).Value(),
+		AuthorRules: cfg.AuthorRules,
+		Themes:      cfg.Themes,
+		Keys:        cfg.Keys,
+		Generators:  cfg.Generators,
+		EmojiRules:  cfg.EmojiRules,
+		Changelog:   cfg.Changelog,
 	}
 }
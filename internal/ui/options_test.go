@@ -177,6 +177,28 @@ func TestToConfig(t *testing.T) {
 				cfg: func(cfg *config.Config) { cfg.View.IgnoreGlobalAuthor = true },
 			},
 		},
+		{
+			name: "edit_mode_vim",
+			args: args{
+				paneSets: func(ps map[string][]setting.Paner) {
+					ps["General"][4] = &setting.Radio{Title: "Edit Mode", Index: toInt(config.EditModeVim)}
+				},
+			},
+			want: want{
+				cfg: func(cfg *config.Config) { cfg.View.EditMode = config.EditModeVim },
+			},
+		},
+		{
+			name: "spell_check",
+			args: args{
+				paneSets: func(ps map[string][]setting.Paner) {
+					ps["General"][5] = &setting.Toggle{Title: "SpellCheck", Enable: true}
+				},
+			},
+			want: want{
+				cfg: func(cfg *config.Config) { cfg.View.SpellCheck = true },
+			},
+		},
 		{
 			name: "colour_unset",
 			args: args{
@@ -309,6 +331,17 @@ func TestToConfig(t *testing.T) {
 				cfg: func(cfg *config.Config) { cfg.Commit.Signoff = true },
 			},
 		},
+		{
+			name: "reflow",
+			args: args{
+				paneSets: func(ps map[string][]setting.Paner) {
+					ps["Commit"][2] = &setting.Toggle{Title: "Reflow", Enable: true}
+				},
+			},
+			want: want{
+				cfg: func(cfg *config.Config) { cfg.Commit.Reflow = true },
+			},
+		},
 		{
 			name: "theme",
 			args: args{
@@ -332,6 +365,114 @@ func TestToConfig(t *testing.T) {
 				cfg: func(cfg *config.Config) { cfg.Authors = testAuthors() },
 			},
 		},
+		{
+			name: "themes",
+			args: args{
+				cfg: config.Config{
+					Themes: []config.CustomTheme{{ID: "custom"}},
+				},
+			},
+			want: want{
+				cfg: func(cfg *config.Config) { cfg.Themes = []config.CustomTheme{{ID: "custom"}} },
+			},
+		},
+		{
+			name: "keys",
+			args: args{
+				cfg: config.Config{
+					Keys: config.Keys{Preset: config.KeyPresetVim},
+				},
+			},
+			want: want{
+				cfg: func(cfg *config.Config) { cfg.Keys = config.Keys{Preset: config.KeyPresetVim} },
+			},
+		},
+		{
+			name: "author_rules",
+			args: args{
+				cfg: config.Config{
+					AuthorRules: testAuthorRules(),
+				},
+			},
+			want: want{
+				cfg: func(cfg *config.Config) { cfg.AuthorRules = testAuthorRules() },
+			},
+		},
+		{
+			name: "generators",
+			args: args{
+				cfg: config.Config{
+					Generators: []config.Generator{{Name: "ai", Command: "generate"}},
+				},
+			},
+			want: want{
+				cfg: func(cfg *config.Config) { cfg.Generators = []config.Generator{{Name: "ai", Command: "generate"}} },
+			},
+		},
+		{
+			name: "emoji_rules",
+			args: args{
+				cfg: config.Config{
+					EmojiRules: []config.EmojiRule{{Emoji: ":memo:", Paths: []string{"docs/**"}}},
+				},
+			},
+			want: want{
+				cfg: func(cfg *config.Config) {
+					cfg.EmojiRules = []config.EmojiRule{{Emoji: ":memo:", Paths: []string{"docs/**"}}}
+				},
+			},
+		},
+		{
+			name: "changelog",
+			args: args{
+				cfg: config.Config{
+					Changelog: testChangelog(),
+				},
+			},
+			want: want{
+				cfg: func(cfg *config.Config) { cfg.Changelog = testChangelog() },
+			},
+		},
+		{
+			name: "authors_add",
+			args: args{
+				paneSets: func(ps map[string][]setting.Paner) {
+					a := setting.ToAuthors(ps["Authors"][0])
+					a.Authors = append(a.Authors, repository.User{Name: "Jane Doe", Email: "jane.doe@example.com"})
+				},
+			},
+			want: want{
+				cfg: func(cfg *config.Config) {
+					cfg.Authors = append(testAuthors(), repository.User{Name: "Jane Doe", Email: "jane.doe@example.com"})
+				},
+			},
+		},
+		{
+			name: "authors_remove",
+			args: args{
+				paneSets: func(ps map[string][]setting.Paner) {
+					setting.ToAuthors(ps["Authors"][0]).Remove()
+				},
+			},
+			want: want{
+				cfg: func(cfg *config.Config) { cfg.Authors = []repository.User{} },
+			},
+		},
+		{
+			name: "authors_default",
+			args: args{
+				paneSets: func(ps map[string][]setting.Paner) {
+					setting.ToAuthors(ps["Authors"][0]).ToggleDefault()
+				},
+			},
+			want: want{
+				cfg: func(cfg *config.Config) {
+					cfg.Authors = []repository.User{
+						{Name: "John Doe", Email: "john.doe@example.com", Default: true},
+					}
+				},
+			},
+		},
 	}
 
 	for _, tt := range tests {
@@ -372,6 +513,8 @@ func testPaneSets() map[string][]setting.Paner {
 			&setting.Radio{Title: "EmojiSelector"},
 			&setting.Radio{Title: "EmojiSet"},
 			&setting.Toggle{Title: "IgnoreGlobalAuthor"},
+			&setting.Radio{Title: "EditMode"},
+			&setting.Toggle{Title: "SpellCheck"},
 		},
 		"Visual": {
 			&setting.Radio{Title: "Colour"},
@@ -381,6 +524,10 @@ func testPaneSets() map[string][]setting.Paner {
 		"Commit": {
 			&setting.Radio{Title: "EmojiType"},
 			&setting.Toggle{Title: "Signoff"},
+			&setting.Toggle{Title: "Reflow"},
+		},
+		"Authors": {
+			&setting.Authors{Title: "Authors", Authors: testAuthors()},
 		},
 	}
 }
@@ -391,6 +538,23 @@ func testAuthors() []repository.User {
 	}
 }
 
+func testAuthorRules() []config.AuthorRule {
+	return []config.AuthorRule{
+		{
+			Author:  repository.User{Name: "John Doe", Email: "john.doe@example.com"},
+			Remotes: []string{"github.com/example/*"},
+		},
+	}
+}
+
+func testChangelog() config.Changelog {
+	return config.Changelog{
+		Sections: []config.ChangelogSection{
+			{Heading: "Fixes", Emojis: []string{":bug:"}},
+		},
+	}
+}
+
 func wantConfig() config.Config {
 	return config.Config{
 		View: config.View{
@@ -399,6 +563,7 @@ func wantConfig() config.Config {
 			EmojiSelector: config.EmojiSelectorBelow,
 			EmojiSet:      config.EmojiSetCommitted,
 			Focus:         config.FocusAuthor,
+			EditMode:      config.EditModeDefault,
 		},
 		Commit: config.Commit{
 			EmojiType: config.EmojiTypeShortcode,
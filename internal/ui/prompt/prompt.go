@@ -0,0 +1,113 @@
+package prompt
+
+import (
+	"strings"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/ui/colour"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+)
+
+// Model is a single line prompt shown below the information.
+type Model struct {
+	Title string
+	Width int
+
+	active bool
+	input  textinput.Model
+	state  *commit.State
+	styles Styles
+}
+
+const (
+	defaultWidth = 72
+	charLimit    = 100
+)
+
+func New(state *commit.State) Model {
+	m := Model{
+		Width:  defaultWidth,
+		state:  state,
+		styles: defaultStyles(state.Theme),
+	}
+
+	m.input = m.newTextInput()
+
+	return m
+}
+
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+//nolint:ireturn
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	var cmd tea.Cmd
+
+	switch msg.(type) {
+	case colour.Msg:
+		m.styles = defaultStyles(m.state.Theme)
+		m.styleTextInput(&m.input)
+	}
+
+	if m.active {
+		m.input, cmd = m.input.Update(msg)
+	}
+
+	return m, cmd
+}
+
+func (m Model) View() string {
+	return m.styles.boundary.Width(m.Width).Render(m.input.View())
+}
+
+// Open shows the prompt with the value.
+func (m *Model) Open(value string) tea.Cmd {
+	m.input = m.newTextInput()
+	m.input.SetValue(value)
+	m.active = true
+
+	return m.input.Focus()
+}
+
+func (m *Model) Close() {
+	m.input.Blur()
+	m.active = false
+}
+
+func (m Model) Active() bool {
+	return m.active
+}
+
+// Value returns the value without surrounding spaces.
+func (m Model) Value() string {
+	return strings.TrimSpace(m.input.Value())
+}
+
+func (m Model) newTextInput() textinput.Model {
+	ti := textinput.New()
+	ti.CharLimit = charLimit
+	ti.Width = m.Width - lipgloss.Width(m.Title) - 3
+
+	m.styleTextInput(&ti)
+
+	return ti
+}
+
+func (m Model) styleTextInput(ti *textinput.Model) {
+	promptMark := m.styles.promptMark.Render("?")
+	promptText := m.styles.promptText.Render(m.Title)
+
+	ti.Prompt = lipgloss.JoinHorizontal(lipgloss.Left, promptMark, promptText)
+	ti.PromptStyle = m.styles.promptStyle
+	ti.TextStyle = m.styles.text
+	ti.PlaceholderStyle = m.styles.placeholder
+	ti.Cursor.Style = m.styles.cursor
+}
+
+func ToModel(m tea.Model, c tea.Cmd) (Model, tea.Cmd) {
+	return m.(Model), c
+}
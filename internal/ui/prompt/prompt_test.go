@@ -0,0 +1,127 @@
+package prompt_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/theme"
+	"github.com/mikelorant/committed/internal/ui/colour"
+	"github.com/mikelorant/committed/internal/ui/prompt"
+	"github.com/mikelorant/committed/internal/ui/uitest"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModel(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		model func(prompt.Model) prompt.Model
+	}
+
+	type want struct {
+		active bool
+		value  string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "default",
+		},
+		{
+			name: "open",
+			args: args{
+				model: func(m prompt.Model) prompt.Model {
+					m.Open("fix-crash")
+					return m
+				},
+			},
+			want: want{
+				active: true,
+				value:  "fix-crash",
+			},
+		},
+		{
+			name: "type",
+			args: args{
+				model: func(m prompt.Model) prompt.Model {
+					m.Open("fix")
+					m, _ = prompt.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("-crash ")}))
+					return m
+				},
+			},
+			want: want{
+				active: true,
+				value:  "fix-crash",
+			},
+		},
+		{
+			name: "inactive",
+			args: args{
+				model: func(m prompt.Model) prompt.Model {
+					m, _ = prompt.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("fix")}))
+					return m
+				},
+			},
+		},
+		{
+			name: "close",
+			args: args{
+				model: func(m prompt.Model) prompt.Model {
+					m.Open("fix-crash")
+					m.Close()
+					return m
+				},
+			},
+			want: want{
+				value: "fix-crash",
+			},
+		},
+		{
+			name: "colour",
+			args: args{
+				model: func(m prompt.Model) prompt.Model {
+					m.Open("fix-crash")
+					m, _ = prompt.ToModel(m.Update(colour.Msg(0)))
+					return m
+				},
+			},
+			want: want{
+				active: true,
+				value:  "fix-crash",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := &commit.State{
+				Theme: theme.New(theme.Default(config.ColourAdaptive)),
+			}
+
+			m := prompt.New(state)
+			m.Title = "New branch:"
+
+			if tt.args.model != nil {
+				m = tt.args.model(m)
+			}
+
+			assert.Equal(t, tt.want.active, m.Active())
+			assert.Equal(t, tt.want.value, m.Value())
+
+			v := uitest.StripString(m.View())
+			autogold.ExpectFile(t, autogold.Raw(v), autogold.Name(tt.name))
+		})
+	}
+}
@@ -0,0 +1,53 @@
+package prompt
+
+import (
+	"github.com/mikelorant/committed/internal/theme"
+	"github.com/mikelorant/committed/internal/ui/colour"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+type Styles struct {
+	boundary    lipgloss.Style
+	promptMark  lipgloss.Style
+	promptText  lipgloss.Style
+	promptStyle lipgloss.Style
+	text        lipgloss.Style
+	placeholder lipgloss.Style
+	cursor      lipgloss.Style
+}
+
+func defaultStyles(th theme.Theme) Styles {
+	var s Styles
+
+	clr := colour.New(th).FilterList()
+
+	s.boundary = lipgloss.NewStyle().
+		MarginLeft(4).
+		MarginBottom(1).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(clr.FocusBoundary)
+
+	s.promptMark = lipgloss.NewStyle().
+		Foreground(clr.TextInputPromptMark).
+		MarginRight(1)
+
+	s.promptText = lipgloss.NewStyle().
+		Foreground(clr.TextInputPromptText).
+		Bold(true).
+		MarginRight(1)
+
+	s.promptStyle = lipgloss.NewStyle().
+		Foreground(clr.TextInputPromptStyle)
+
+	s.text = lipgloss.NewStyle().
+		Foreground(clr.TextInputTextStyle)
+
+	s.placeholder = lipgloss.NewStyle().
+		Foreground(clr.TextInputPlaceholderStyle)
+
+	s.cursor = lipgloss.NewStyle().
+		Foreground(clr.TextInputCursorStyle)
+
+	return s
+}
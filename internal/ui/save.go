@@ -21,6 +21,13 @@ func (m *Model) backupModel() savedState {
 func (m *Model) setSaves() {
 	m.file = m.state.Options.File.MessageFile != ""
 	m.amend = m.state.Options.Amend || m.state.File.Amend
+	m.reword = m.state.Reword.Hash != ""
+
+	if m.reword {
+		m.currentSave = defaultRewordSave(m.state)
+
+		return
+	}
 
 	switch m.amend {
 	case true:
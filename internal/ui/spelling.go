@@ -0,0 +1,80 @@
+package ui
+
+import (
+	"github.com/mikelorant/committed/internal/ui/suggestion"
+
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+const spellingTitle = "Spelling"
+
+// Offset of the suggestions from the top right corner of the body.
+const (
+	suggestionRow    = 2
+	suggestionColumn = 2
+)
+
+// openSpelling shows the suggestions for the word at the cursor of the
+// summary or body.
+func (m *Model) openSpelling() {
+	var word string
+
+	switch m.focus {
+	case summaryComponent:
+		word = m.models.header.Word()
+	case bodyComponent:
+		word = m.models.body.Word()
+	default:
+		return
+	}
+
+	if word == "" || m.state.Spelling == nil {
+		return
+	}
+
+	m.models.suggestion.Open(word, m.state.Spelling.Suggest(word))
+}
+
+// onSpellingKeyPress handles keys while the suggestions are shown. Enter
+// replaces the word with the selected suggestion and escape closes the
+// suggestions.
+func (m Model) onSpellingKeyPress(msg tea.KeyMsg) keyResponse {
+	switch msg.String() {
+	case "enter":
+		if s := m.models.suggestion.Selected(); s != "" {
+			switch m.focus {
+			case summaryComponent:
+				m.models.header.ReplaceWord(s)
+			case bodyComponent:
+				m.models.body.ReplaceWord(s)
+			}
+		}
+
+		m.models.suggestion.Close()
+	case "esc":
+		m.models.suggestion.Close()
+	default:
+		m.models.suggestion, _ = suggestion.ToModel(m.models.suggestion.Update(msg))
+
+		return keyResponse{model: m, end: true}
+	}
+
+	return keyResponse{model: m, nilMsg: true}
+}
+
+// bodyView draws the spelling or generated suggestions over the body when
+// they are shown.
+func (m Model) bodyView() string {
+	view := m.models.body.View()
+
+	switch {
+	case m.models.suggestion.Active():
+		return m.models.suggestion.Overlay(view, suggestionColumn, suggestionRow)
+	case m.models.generate.Active():
+		return m.models.generate.Overlay(view, suggestionColumn, suggestionRow)
+	case m.models.fixup.Active():
+		return m.models.fixup.Overlay(view, suggestionColumn, suggestionRow)
+	}
+
+	return view
+}
@@ -1,24 +1,32 @@
 package status
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/keymap"
+	"github.com/mikelorant/committed/internal/ui/colour"
 	"github.com/mikelorant/committed/internal/ui/shortcut"
 
 	tea "github.com/charmbracelet/bubbletea"
 )
 
+// Model is the shortcuts with the edit mode or an error shown below. The
+// error takes the place of the mode until it is cleared.
 type Model struct {
 	Shortcuts shortcut.Shortcuts
+	Mode      string
+	Error     string
 	shortcut  shortcut.Model
 	state     *commit.State
+	styles    Styles
 }
 
 func New(state *commit.State) Model {
 	ds := shortcut.Shortcuts{
 		Modifiers:   defaultModifiers(),
-		KeyBindings: defaultKeyBindings(),
+		KeyBindings: defaultKeyBindings(state.KeyMap),
 		State:       state,
 	}
 
@@ -26,6 +34,7 @@ func New(state *commit.State) Model {
 		Shortcuts: ds,
 		shortcut:  shortcut.New(ds),
 		state:     state,
+		styles:    defaultStyles(state.Theme),
 	}
 }
 
@@ -35,6 +44,12 @@ func (m Model) Init() tea.Cmd {
 
 //nolint:ireturn
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	//nolint:gocritic
+	switch msg.(type) {
+	case colour.Msg:
+		m.styles = defaultStyles(m.state.Theme)
+	}
+
 	m.Shortcuts.State = m.state
 	m.shortcut.Shortcuts = m.Shortcuts
 	m.shortcut, _ = shortcut.ToModel(m.shortcut.Update(nil))
@@ -43,12 +58,27 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 }
 
 func (m Model) View() string {
-	return m.shortcut.View()
+	var line string
+
+	switch {
+	case m.Error != "":
+		line = m.styles.err.Render(m.Error)
+	case m.Mode != "":
+		line = m.styles.mode.Render(fmt.Sprintf("-- %s --", m.Mode))
+	default:
+		return m.shortcut.View()
+	}
+
+	// The line replaces the blank line below the shortcuts.
+	lines := strings.Split(m.shortcut.View(), "\n")
+	lines[len(lines)-1] = line
+
+	return strings.Join(lines, "\n")
 }
 
-func GlobalShortcuts(next, previous string) shortcut.Shortcuts {
+func GlobalShortcuts(km keymap.KeyMap, next, previous string) shortcut.Shortcuts {
 	mods := defaultModifiers()
-	kb := defaultKeyBindings()
+	kb := defaultKeyBindings(km)
 
 	switch next {
 	case "":
@@ -66,11 +96,7 @@ func GlobalShortcuts(next, previous string) shortcut.Shortcuts {
 			Align:    shortcut.AlignRight,
 		})
 
-		kb = append(kb, shortcut.KeyBinding{
-			Modifier: shortcut.NoModifier,
-			Key:      "tab",
-			Label:    next,
-		})
+		kb = append(kb, keyBinding(km.Binding(keymap.ActionNext).Key(), next))
 	}
 
 	switch previous {
@@ -90,11 +116,7 @@ func GlobalShortcuts(next, previous string) shortcut.Shortcuts {
 			Label:    "Shift", Align: shortcut.AlignRight,
 		})
 
-		kb = append(kb, shortcut.KeyBinding{
-			Modifier: shortcut.ShiftModifier,
-			Key:      "tab",
-			Label:    previous,
-		})
+		kb = append(kb, keyBinding(km.Binding(keymap.ActionPrevious).Key(), previous))
 	}
 
 	return shortcut.Shortcuts{
@@ -103,8 +125,8 @@ func GlobalShortcuts(next, previous string) shortcut.Shortcuts {
 	}
 }
 
-func HelpShortcuts() shortcut.Shortcuts {
-	kb := defaultKeyBindings()
+func HelpShortcuts(km keymap.KeyMap) shortcut.Shortcuts {
+	kb := defaultKeyBindings(km)
 	mods := defaultModifiers()
 
 	mods = append(mods, shortcut.Modifier{
@@ -124,8 +146,8 @@ func HelpShortcuts() shortcut.Shortcuts {
 	}
 }
 
-func OptionShortcuts() shortcut.Shortcuts {
-	kb := defaultKeyBindings()[:1]
+func OptionShortcuts(km keymap.KeyMap) shortcut.Shortcuts {
+	kb := bindings(km, keymap.ActionCancel, keymap.ActionWrite)
 	mods := defaultModifiers()
 
 	mods = append(mods, shortcut.Modifier{
@@ -133,12 +155,6 @@ func OptionShortcuts() shortcut.Shortcuts {
 		Align:    shortcut.AlignRight,
 	})
 
-	kb = append(kb, shortcut.KeyBinding{
-		Modifier: shortcut.AltModifier,
-		Key:      "w",
-		Label:    "Write",
-	})
-
 	kb = append(kb, shortcut.KeyBinding{
 		Modifier: shortcut.NoModifier,
 		Key:      "↑→↓←",
@@ -180,14 +196,50 @@ func defaultModifiers() []shortcut.Modifier {
 	}
 }
 
-func defaultKeyBindings() []shortcut.KeyBinding {
-	return []shortcut.KeyBinding{
-		{Modifier: shortcut.ControlModifier, Key: "c", Label: "Cancel"},
-		{Modifier: shortcut.ControlModifier, Key: "o", Label: "Options"},
-		{Modifier: shortcut.ControlModifier, Key: "h", Label: "Help"},
-		{Modifier: shortcut.AltModifier, Key: "enter", Label: "Commit"},
-		{Modifier: shortcut.AltModifier, Key: "a", Label: "Amend"},
-		{Modifier: shortcut.AltModifier, Key: "l", Label: "Load"},
-		{Modifier: shortcut.AltModifier, Key: "s", Label: "Sign-off"},
+func defaultKeyBindings(km keymap.KeyMap) []shortcut.KeyBinding {
+	return bindings(km,
+		keymap.ActionCancel,
+		keymap.ActionOptions,
+		keymap.ActionHelp,
+		keymap.ActionCommit,
+		keymap.ActionAmend,
+		keymap.ActionLoad,
+		keymap.ActionSignoff,
+		keymap.ActionEditor,
+	)
+}
+
+func bindings(km keymap.KeyMap, as ...keymap.Action) []shortcut.KeyBinding {
+	var kb []shortcut.KeyBinding
+
+	for _, a := range as {
+		b := km.Binding(a)
+		if b.Key() == "" {
+			continue
+		}
+
+		kb = append(kb, keyBinding(b.Key(), b.Label))
 	}
+
+	return kb
+}
+
+// keyBinding splits the modifier from a key such as "alt+enter".
+func keyBinding(key, label string) shortcut.KeyBinding {
+	mods := []struct {
+		prefix   string
+		modifier int
+	}{
+		{prefix: "alt+", modifier: shortcut.AltModifier},
+		{prefix: "ctrl+", modifier: shortcut.ControlModifier},
+		{prefix: "shift+", modifier: shortcut.ShiftModifier},
+	}
+
+	for _, m := range mods {
+		if k, ok := strings.CutPrefix(key, m.prefix); ok {
+			return shortcut.KeyBinding{Modifier: m.modifier, Key: k, Label: label}
+		}
+	}
+
+	return shortcut.KeyBinding{Modifier: shortcut.NoModifier, Key: key, Label: label}
 }
@@ -5,6 +5,7 @@ import (
 
 	"github.com/mikelorant/committed/internal/commit"
 	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/keymap"
 	"github.com/mikelorant/committed/internal/theme"
 	"github.com/mikelorant/committed/internal/ui/status"
 	"github.com/mikelorant/committed/internal/ui/uitest"
@@ -25,6 +26,9 @@ func TestModel(t *testing.T) {
 		shortcuts int
 		next      string
 		previous  string
+		keys      config.Keys
+		mode      string
+		err       string
 	}
 
 	type want struct{}
@@ -63,6 +67,53 @@ func TestModel(t *testing.T) {
 				previous: "previous",
 			},
 		},
+		{
+			name: "vim",
+			args: args{
+				next:     "next",
+				previous: "previous",
+				keys:     config.Keys{Preset: config.KeyPresetVim},
+			},
+		},
+		{
+			name: "emacs",
+			args: args{
+				next:     "next",
+				previous: "previous",
+				keys:     config.Keys{Preset: config.KeyPresetEmacs},
+			},
+		},
+		{
+			name: "custom",
+			args: args{
+				next:     "next",
+				previous: "previous",
+				keys: config.Keys{
+					Bindings: map[string]config.KeyList{
+						"next":  {"ctrl+n"},
+						"load":  {},
+						"amend": {"ctrl+a"},
+					},
+				},
+			},
+		},
+		{
+			name: "mode",
+			args: args{
+				next:     "next",
+				previous: "previous",
+				mode:     "INSERT",
+			},
+		},
+		{
+			name: "error",
+			args: args{
+				next:     "next",
+				previous: "previous",
+				mode:     "INSERT",
+				err:      "unable to run editor: exit status 1",
+			},
+		},
 		{
 			name: "help",
 			args: args{
@@ -83,21 +134,30 @@ func TestModel(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Parallel()
 
+			km, err := keymap.New(tt.args.keys)
+			if err != nil {
+				t.Fatal(err)
+			}
+
 			state := &commit.State{
-				Theme: theme.New(theme.Default(config.ColourAdaptive)),
+				Theme:  theme.New(theme.Default(config.ColourAdaptive)),
+				KeyMap: km,
 			}
 
 			m := status.New(state)
 
 			switch tt.args.shortcuts {
 			case helpShortcuts:
-				m.Shortcuts = status.HelpShortcuts()
+				m.Shortcuts = status.HelpShortcuts(state.KeyMap)
 			case optionShortcuts:
-				m.Shortcuts = status.OptionShortcuts()
+				m.Shortcuts = status.OptionShortcuts(state.KeyMap)
 			default:
-				m.Shortcuts = status.GlobalShortcuts(tt.args.next, tt.args.previous)
+				m.Shortcuts = status.GlobalShortcuts(state.KeyMap, tt.args.next, tt.args.previous)
 			}
 
+			m.Mode = tt.args.mode
+			m.Error = tt.args.err
+
 			m, _ = status.ToModel(m.Update(nil))
 
 			v := uitest.StripString(m.View())
@@ -0,0 +1,30 @@
+package status
+
+import (
+	"github.com/mikelorant/committed/internal/theme"
+	"github.com/mikelorant/committed/internal/ui/colour"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+type Styles struct {
+	mode lipgloss.Style
+	err  lipgloss.Style
+}
+
+func defaultStyles(th theme.Theme) Styles {
+	var s Styles
+
+	clr := colour.New(th).Status()
+
+	s.mode = lipgloss.NewStyle().
+		Foreground(clr.Mode).
+		Bold(true).
+		PaddingLeft(1)
+
+	s.err = lipgloss.NewStyle().
+		Foreground(clr.Error).
+		PaddingLeft(1)
+
+	return s
+}
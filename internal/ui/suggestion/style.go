@@ -0,0 +1,38 @@
+package suggestion
+
+import (
+	"github.com/mikelorant/committed/internal/theme"
+	"github.com/mikelorant/committed/internal/ui/colour"
+
+	"github.com/charmbracelet/lipgloss"
+)
+
+type Styles struct {
+	boundary           lipgloss.Style
+	title              lipgloss.Style
+	suggestion         lipgloss.Style
+	suggestionSelected lipgloss.Style
+}
+
+func defaultStyles(th theme.Theme) Styles {
+	var s Styles
+
+	clr := colour.New(th).Spelling()
+
+	s.boundary = lipgloss.NewStyle().
+		Align(lipgloss.Left, lipgloss.Top).
+		BorderStyle(lipgloss.NormalBorder()).
+		BorderForeground(clr.Boundary).
+		Padding(0, 1, 0, 1)
+
+	s.title = lipgloss.NewStyle().
+		Foreground(clr.Title)
+
+	s.suggestion = lipgloss.NewStyle().
+		Foreground(clr.Suggestion)
+
+	s.suggestionSelected = lipgloss.NewStyle().
+		Foreground(clr.SuggestionSelected)
+
+	return s
+}
@@ -0,0 +1,165 @@
+package suggestion
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/ui/colour"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+)
+
+type Model struct {
+	Title       string
+	Word        string
+	Suggestions []string
+	Width       int
+
+	active bool
+	index  int
+	state  *commit.State
+	styles Styles
+}
+
+const (
+	promptSelected = "❯ "
+	promptNormal   = "  "
+
+	noSuggestions = "No suggestions"
+
+	defaultWidth = 30
+
+	// Width of the padding.
+	paddingWidth = 2
+	ellipsis     = "…"
+)
+
+func New(state *commit.State) Model {
+	return Model{
+		Width:  defaultWidth,
+		state:  state,
+		styles: defaultStyles(state.Theme),
+	}
+}
+
+func (m Model) Init() tea.Cmd {
+	return nil
+}
+
+//nolint:ireturn
+func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case colour.Msg:
+		m.styles = defaultStyles(m.state.Theme)
+	case tea.KeyMsg:
+		if !m.active || len(m.Suggestions) == 0 {
+			break
+		}
+
+		switch msg.String() {
+		case "up", "shift+tab":
+			m.index = (m.index - 1 + len(m.Suggestions)) % len(m.Suggestions)
+		case "down", "tab":
+			m.index = (m.index + 1) % len(m.Suggestions)
+		}
+	}
+
+	return m, nil
+}
+
+func (m Model) View() string {
+	boundary := m.styles.boundary.Width(m.Width)
+
+	title := m.Title
+	if m.Word != "" {
+		title = fmt.Sprintf("%s: %s", m.Title, m.Word)
+	}
+
+	title = m.styles.title.Render(m.truncate(title))
+
+	if len(m.Suggestions) == 0 {
+		return boundary.Render(title + "\n" + m.styles.suggestion.Render(noSuggestions))
+	}
+
+	items := make([]string, len(m.Suggestions))
+
+	for i, s := range m.Suggestions {
+		if i == m.index {
+			items[i] = m.styles.suggestionSelected.Render(m.truncate(promptSelected + s))
+
+			continue
+		}
+
+		items[i] = m.styles.suggestion.Render(m.truncate(promptNormal + s))
+	}
+
+	return boundary.Render(title + "\n" + strings.Join(items, "\n"))
+}
+
+// Open shows the suggestions for the word.
+func (m *Model) Open(word string, suggestions []string) {
+	m.Word = word
+	m.Suggestions = suggestions
+	m.active = true
+	m.index = 0
+}
+
+func (m *Model) Close() {
+	m.active = false
+}
+
+func (m Model) Active() bool {
+	return m.active
+}
+
+// Index returns the index of the selected suggestion.
+func (m Model) Index() int {
+	return m.index
+}
+
+// Selected returns the selected suggestion or an empty string when there are
+// no suggestions.
+func (m Model) Selected() string {
+	if len(m.Suggestions) == 0 {
+		return ""
+	}
+
+	return m.Suggestions[m.index]
+}
+
+// Overlay draws the suggestions over the view with the top right corner
+// offset from the top right corner of the view.
+func (m Model) Overlay(view string, x, y int) string {
+	fg := m.View()
+	bgLines := strings.Split(view, "\n")
+
+	if y >= len(bgLines) {
+		return view
+	}
+
+	left := ansi.StringWidth(bgLines[y]) - lipgloss.Width(fg) - x
+
+	for i, l := range strings.Split(fg, "\n") {
+		row := y + i
+		if row >= len(bgLines) {
+			break
+		}
+
+		b := bgLines[row]
+		bgLines[row] = ansi.Truncate(b, left, "") + l + ansi.TruncateLeft(b, left+ansi.StringWidth(l), "")
+	}
+
+	return strings.Join(bgLines, "\n")
+}
+
+// truncate shortens lines wider than the suggestions.
+func (m Model) truncate(str string) string {
+	return ansi.Truncate(str, m.Width-paddingWidth, ellipsis)
+}
+
+func ToModel(m tea.Model, c tea.Cmd) (Model, tea.Cmd) {
+	return m.(Model), c
+}
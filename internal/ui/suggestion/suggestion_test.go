@@ -0,0 +1,227 @@
+package suggestion_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/commit"
+	"github.com/mikelorant/committed/internal/config"
+	"github.com/mikelorant/committed/internal/theme"
+	"github.com/mikelorant/committed/internal/ui/colour"
+	"github.com/mikelorant/committed/internal/ui/suggestion"
+	"github.com/mikelorant/committed/internal/ui/uitest"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/hexops/autogold/v2"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestModel(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		model func(suggestion.Model) suggestion.Model
+	}
+
+	type want struct {
+		active   bool
+		selected string
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "default",
+		},
+		{
+			name: "open",
+			args: args{
+				model: func(m suggestion.Model) suggestion.Model {
+					m.Open("teh", []string{"the", "tea", "ten"})
+					return m
+				},
+			},
+			want: want{
+				active:   true,
+				selected: "the",
+			},
+		},
+		{
+			name: "no_suggestions",
+			args: args{
+				model: func(m suggestion.Model) suggestion.Model {
+					m.Open("qqqq", nil)
+					return m
+				},
+			},
+			want: want{
+				active: true,
+			},
+		},
+		{
+			name: "down",
+			args: args{
+				model: func(m suggestion.Model) suggestion.Model {
+					m.Open("teh", []string{"the", "tea", "ten"})
+					m, _ = suggestion.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					return m
+				},
+			},
+			want: want{
+				active:   true,
+				selected: "tea",
+			},
+		},
+		{
+			name: "up_wrap",
+			args: args{
+				model: func(m suggestion.Model) suggestion.Model {
+					m.Open("teh", []string{"the", "tea", "ten"})
+					m, _ = suggestion.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyUp}))
+					return m
+				},
+			},
+			want: want{
+				active:   true,
+				selected: "ten",
+			},
+		},
+		{
+			name: "tab",
+			args: args{
+				model: func(m suggestion.Model) suggestion.Model {
+					m.Open("teh", []string{"the", "tea", "ten"})
+					m, _ = suggestion.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyTab}))
+					m, _ = suggestion.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyTab}))
+					return m
+				},
+			},
+			want: want{
+				active:   true,
+				selected: "ten",
+			},
+		},
+		{
+			name: "reopen",
+			args: args{
+				model: func(m suggestion.Model) suggestion.Model {
+					m.Open("teh", []string{"the", "tea", "ten"})
+					m, _ = suggestion.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					m.Open("teh", []string{"the", "tea", "ten"})
+					return m
+				},
+			},
+			want: want{
+				active:   true,
+				selected: "the",
+			},
+		},
+		{
+			name: "close",
+			args: args{
+				model: func(m suggestion.Model) suggestion.Model {
+					m.Open("teh", []string{"the", "tea", "ten"})
+					m.Close()
+					return m
+				},
+			},
+			want: want{
+				selected: "the",
+			},
+		},
+		{
+			name: "truncate",
+			args: args{
+				model: func(m suggestion.Model) suggestion.Model {
+					m.Open("word", []string{"internal/ui/suggestion/suggestion_test.go"})
+					return m
+				},
+			},
+			want: want{
+				active:   true,
+				selected: "internal/ui/suggestion/suggestion_test.go",
+			},
+		},
+		{
+			name: "width",
+			args: args{
+				model: func(m suggestion.Model) suggestion.Model {
+					m.Width = 50
+					m.Open("word", []string{"internal/ui/suggestion/suggestion_test.go"})
+					return m
+				},
+			},
+			want: want{
+				active:   true,
+				selected: "internal/ui/suggestion/suggestion_test.go",
+			},
+		},
+		{
+			name: "no_word",
+			args: args{
+				model: func(m suggestion.Model) suggestion.Model {
+					m.Open("", []string{"the"})
+					return m
+				},
+			},
+			want: want{
+				active:   true,
+				selected: "the",
+			},
+		},
+		{
+			name: "inactive",
+			args: args{
+				model: func(m suggestion.Model) suggestion.Model {
+					m.Suggestions = []string{"the", "tea", "ten"}
+					m, _ = suggestion.ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					return m
+				},
+			},
+			want: want{
+				selected: "the",
+			},
+		},
+		{
+			name: "colour",
+			args: args{
+				model: func(m suggestion.Model) suggestion.Model {
+					m.Open("teh", []string{"the"})
+					m, _ = suggestion.ToModel(m.Update(colour.Msg(0)))
+					return m
+				},
+			},
+			want: want{
+				active:   true,
+				selected: "the",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			state := &commit.State{
+				Theme: theme.New(theme.Default(config.ColourAdaptive)),
+			}
+
+			m := suggestion.New(state)
+			m.Title = "Spelling"
+
+			if tt.args.model != nil {
+				m = tt.args.model(m)
+			}
+
+			assert.Equal(t, tt.want.active, m.Active())
+			assert.Equal(t, tt.want.selected, m.Selected())
+
+			v := uitest.StripString(m.View())
+			autogold.ExpectFile(t, autogold.Raw(v), autogold.Name(tt.name))
+		})
+	}
+}
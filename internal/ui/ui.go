@@ -8,6 +8,9 @@ import (
 	"github.com/mikelorant/committed/internal/commit"
 	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/generator"
+	"github.com/mikelorant/committed/internal/keymap"
+	"github.com/mikelorant/committed/internal/repository"
 	"github.com/mikelorant/committed/internal/terminal"
 	"github.com/mikelorant/committed/internal/ui/body"
 	"github.com/mikelorant/committed/internal/ui/colour"
@@ -17,7 +20,9 @@ import (
 	"github.com/mikelorant/committed/internal/ui/info"
 	"github.com/mikelorant/committed/internal/ui/message"
 	"github.com/mikelorant/committed/internal/ui/option"
+	"github.com/mikelorant/committed/internal/ui/prompt"
 	"github.com/mikelorant/committed/internal/ui/status"
+	"github.com/mikelorant/committed/internal/ui/suggestion"
 
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
@@ -32,6 +37,7 @@ type Model struct {
 	models        Models
 	quit          quit
 	amend         bool
+	reword        bool
 	file          bool
 	signoff       bool
 	err           error
@@ -40,6 +46,9 @@ type Model struct {
 	currentSave   savedState
 	previousSave  savedState
 	emojiType     config.EmojiType
+	generated     []generator.Suggestion
+	fixup         commit.Fixup
+	targets       []repository.Head
 }
 
 type Models struct {
@@ -51,6 +60,11 @@ type Models struct {
 	help    help.Model
 	message message.Model
 	option  option.Model
+
+	suggestion suggestion.Model
+	generate   suggestion.Model
+	fixup      suggestion.Model
+	branch     prompt.Model
 }
 
 type savedState struct {
@@ -103,19 +117,6 @@ const (
 	bodyName    = "Body"
 )
 
-const (
-	KeyAmend   = "å"
-	KeyLoad    = "¬"
-	KeySignoff = "ß"
-	KeyTheme   = "†"
-	KeyAuthor  = "¡"
-	KeyEmoji   = "™"
-	KeySummary = "£"
-	KeyBody    = "¢"
-	KeyHelp    = "˙"
-	KeyOption  = "ø"
-)
-
 const dateTimeFormat = "Mon Jan 2 15:04:05 2006 -0700"
 
 func New() Model {
@@ -136,16 +137,32 @@ func (m *Model) Configure(state *commit.State) {
 		status: status.New(state),
 		help:   help.New(state),
 		option: option.New(state),
+
+		suggestion: suggestion.New(state),
+		generate:   suggestion.New(state),
+		fixup:      suggestion.New(state),
+		branch:     prompt.New(state),
 	}
 
-	m.models.info.Date = m.Date.Format(dateTimeFormat)
+	if m.state.Identity.AuthorDate.IsZero() {
+		m.models.info.Date = m.Date.Format(dateTimeFormat)
+	}
+	m.models.suggestion.Title = spellingTitle
+	m.models.generate.Title = generateTitle
+	m.models.generate.Width = generateWidth
+	m.models.fixup.Width = fixupWidth
+	m.models.branch.Title = branchTitle
 
 	m.setSaves()
 	m.restoreModel(m.currentSave)
 	m.setCompatibility()
 	m.configureOptions()
 
-	if (m.state.Snapshot.Restore && m.setSave()) || m.file {
+	// Messages prepared by Git and earlier commits being reworded are edited
+	// rather than replaced by the snapshot.
+	restore := m.state.Snapshot.Restore && !m.state.File.Source.Generated() && !m.reword
+
+	if (restore && m.setSave()) || m.file {
 		m.resetCursor()
 	}
 }
@@ -182,9 +199,10 @@ func (m Model) Init() tea.Cmd {
 
 //nolint:ireturn
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
-	//nolint:gocritic
 	switch msgType := msg.(type) {
 	case tea.KeyMsg:
+		m.models.status.Error = ""
+
 		resp := m.onKeyPress(msgType)
 		switch {
 		case resp.end:
@@ -194,6 +212,12 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		}
 
 		m = resp.model
+	case EditorMsg:
+		m.restoreEditor(msgType)
+		msg = nil
+	case GenerateMsg:
+		m.openGenerate(msgType)
+		msg = nil
 	}
 
 	m = m.resetModels()
@@ -220,7 +244,7 @@ func (m Model) View() string {
 
 	if m.focus == helpComponent {
 		return lipgloss.JoinVertical(lipgloss.Top,
-			m.models.info.View(),
+			m.infoView(),
 			m.models.help.View(),
 			m.models.status.View(),
 		)
@@ -228,7 +252,7 @@ func (m Model) View() string {
 
 	if m.focus == optionComponent {
 		return lipgloss.JoinVertical(lipgloss.Top,
-			m.models.info.View(),
+			m.infoView(),
 			m.models.option.View(),
 			m.models.status.View(),
 		)
@@ -236,15 +260,15 @@ func (m Model) View() string {
 
 	if !m.models.footer.Signoff {
 		return lipgloss.JoinVertical(lipgloss.Top,
-			m.models.info.View(),
+			m.infoView(),
 			m.models.header.View(),
-			m.models.body.View(),
+			m.bodyView(),
 			m.models.status.View(),
 		)
 	}
 
 	return lipgloss.JoinVertical(lipgloss.Top,
-		m.models.info.View(),
+		m.infoView(),
 		m.models.header.View(),
 		m.models.body.View(),
 		m.models.footer.View(),
@@ -253,39 +277,76 @@ func (m Model) View() string {
 }
 
 func (m Model) onKeyPress(msg tea.KeyMsg) keyResponse {
+	if m.models.suggestion.Active() {
+		return m.onSpellingKeyPress(msg)
+	}
+
+	if m.models.generate.Active() {
+		return m.onGenerateKeyPress(msg)
+	}
+
+	if m.models.fixup.Active() {
+		return m.onFixupKeyPress(msg)
+	}
+
+	if m.models.branch.Active() {
+		return m.onBranchKeyPress(msg)
+	}
+
+	// Keys are handled by the body while completions are shown so they choose
+	// a completion rather than changing the focus.
+	if m.focus == bodyComponent && m.models.body.Completing() {
+		return keyResponse{model: m}
+	}
+
 	switch msg.String() {
-	case "alt+1", KeyAuthor:
+	case "enter":
+		switch m.focus {
+		case authorComponent:
+			m.models.info, _ = info.ToModel(m.models.info.Update(msg))
+			m.focus = emojiComponent
+		case emojiComponent:
+			m.models.header, _ = header.ToModel(m.models.header.Update(msg))
+			m.focus = summaryComponent
+		case summaryComponent:
+			m.focus = bodyComponent
+		}
+
+		return keyResponse{model: m}
+	case "esc":
+		if m.focus == optionComponent && m.models.option.Editing() {
+			break
+		}
+
+		if m.focus == helpComponent || m.focus == optionComponent {
+			m.focus = m.previousFocus
+		}
+
+		return keyResponse{model: m}
+	}
+
+	switch m.state.KeyMap.Action(msg.String()) {
+	case keymap.ActionAuthor:
 		if m.focus == authorComponent {
 			return keyResponse{model: m, nilMsg: true}
 		}
 		m.focus = authorComponent
-	case "alt+2", KeyEmoji:
+	case keymap.ActionEmoji:
 		if m.focus == emojiComponent {
 			return keyResponse{model: m, nilMsg: true}
 		}
 		m.focus = emojiComponent
-	case "alt+3", KeySummary:
+	case keymap.ActionSummary:
 		if m.focus == summaryComponent {
 			return keyResponse{model: m, nilMsg: true}
 		}
 		m.focus = summaryComponent
-	case "alt+4", KeyBody:
+	case keymap.ActionBody:
 		if m.focus == bodyComponent {
 			return keyResponse{model: m, nilMsg: true}
 		}
 		m.focus = bodyComponent
-	case "enter":
-		switch m.focus {
-		case authorComponent:
-			m.models.info, _ = info.ToModel(m.models.info.Update(msg))
-			m.focus = emojiComponent
-		case emojiComponent:
-			m.models.header, _ = header.ToModel(m.models.header.Update(msg))
-			m.focus = summaryComponent
-		case summaryComponent:
-			m.focus = bodyComponent
-		}
-	case "alt+enter", "alt+\\":
+	case keymap.ActionCommit:
 		if !m.validate() {
 			break
 		}
@@ -293,51 +354,117 @@ func (m Model) onKeyPress(msg tea.KeyMsg) keyResponse {
 		m = m.commit(applyQuit)
 
 		return keyResponse{model: m, cmd: tea.Quit, end: true}
-	case "alt+a", KeyAmend:
+	case keymap.ActionAmend:
+		// The earlier commit being reworded is amended instead of the head.
+		if m.reword {
+			return keyResponse{model: m, nilMsg: true}
+		}
+
 		m.amend = !m.amend
 
+		// Amending the head replaces the fixup of an earlier commit.
+		if m.amend {
+			m.setFixup(repository.Head{})
+		}
+
 		m.swapSave()
 
 		m.models.header.CursorStartSummary()
 		m.models.body.CursorStart()
 
 		return keyResponse{model: m, end: false, nilMsg: true}
-	case "alt+l", KeyLoad:
+	case keymap.ActionLoad:
 		if m.setSave() {
 			m.models.header.CursorStartSummary()
 			m.models.body.CursorStart()
 		}
 
 		return keyResponse{model: m, end: false, nilMsg: true}
-	case "alt+s", KeySignoff:
+	case keymap.ActionSignoff:
 		m.signoff = !m.signoff
 
 		return keyResponse{model: m, end: false, nilMsg: true}
-	case "alt+t", KeyTheme:
+	case keymap.ActionTheme:
 		m.state.Theme.Next()
 		return keyResponse{model: m, cmd: colour.Update, end: true}
-	case "ctrl+h", KeyHelp:
+	case keymap.ActionHelp:
 		if m.focus == helpComponent {
 			m.focus = m.previousFocus
 			break
 		}
 		m.previousFocus = m.focus
 		m.focus = helpComponent
-	case "ctrl+o", KeyOption:
+	case keymap.ActionOptions:
 		if m.focus == optionComponent {
 			m.focus = m.previousFocus
 			break
 		}
 		m.previousFocus = m.focus
 		m.focus = optionComponent
-	case "ctrl+w":
+	case keymap.ActionWrite:
 		m.state.Config = ToConfig(m.state.Config, m.models.option.GetPaneSets(), m.state.Theme)
 		m.writeConfig = true
-	case "esc":
+	case keymap.ActionEditor:
 		if m.focus == helpComponent || m.focus == optionComponent {
-			m.focus = m.previousFocus
+			break
+		}
+
+		return keyResponse{model: m, cmd: m.openEditor(), end: true}
+	case keymap.ActionReflow:
+		if m.focus == helpComponent || m.focus == optionComponent {
+			break
+		}
+
+		m.models.body.Reflow()
+
+		return keyResponse{model: m, nilMsg: true}
+	case keymap.ActionSpelling:
+		m.openSpelling()
+
+		return keyResponse{model: m, nilMsg: true}
+	case keymap.ActionGenerate:
+		if m.focus == helpComponent || m.focus == optionComponent {
+			break
+		}
+
+		if len(m.state.Generators) == 0 {
+			return keyResponse{model: m, nilMsg: true}
+		}
+
+		return keyResponse{model: m, cmd: m.generate(), end: true}
+	case keymap.ActionBranch:
+		// Messages written for Git are committed by Git to the current branch.
+		if m.focus == helpComponent || m.focus == optionComponent || m.file {
+			break
+		}
+
+		// Rewording rebases the current branch.
+		if m.reword {
+			return keyResponse{model: m, nilMsg: true}
 		}
-	case "tab":
+
+		return keyResponse{model: m, cmd: m.openBranch(), end: true}
+	case keymap.ActionFixup:
+		if m.focus == helpComponent || m.focus == optionComponent || m.file {
+			break
+		}
+
+		// The head or the commit being reworded is amended rather than an
+		// earlier commit fixed up.
+		if m.amend || m.reword {
+			return keyResponse{model: m, nilMsg: true}
+		}
+
+		m.openFixup()
+
+		return keyResponse{model: m, nilMsg: true}
+	case keymap.ActionComplete:
+		if m.focus == bodyComponent {
+			m.models.body.Complete()
+		}
+
+		return keyResponse{model: m, nilMsg: true}
+	case keymap.ActionNext:
 		switch m.focus {
 		case authorComponent:
 			m.focus = emojiComponent
@@ -346,7 +473,7 @@ func (m Model) onKeyPress(msg tea.KeyMsg) keyResponse {
 		case summaryComponent:
 			m.focus = bodyComponent
 		}
-	case "shift+tab":
+	case keymap.ActionPrevious:
 		switch m.focus {
 		case emojiComponent:
 			m.focus = authorComponent
@@ -355,7 +482,7 @@ func (m Model) onKeyPress(msg tea.KeyMsg) keyResponse {
 		case bodyComponent:
 			m.focus = summaryComponent
 		}
-	case "ctrl+c":
+	case keymap.ActionCancel:
 		m = m.commit(cancelQuit)
 
 		return keyResponse{model: m, cmd: tea.Quit, end: true}
@@ -385,25 +512,25 @@ func (m Model) setModels() Model {
 		m.models.info.Focus()
 		m.models.info.Expand = true
 		m.models.body.Height = bodyAuthorHeight
-		m.models.status.Shortcuts = status.GlobalShortcuts(emojiName, emptyName)
+		m.models.status.Shortcuts = status.GlobalShortcuts(m.state.KeyMap, emojiName, emptyName)
 	case emojiComponent:
 		m.models.header.Focus()
 		m.models.header.SelectEmoji()
 		m.models.header.Expand = true
 		m.models.body.Height = bodyEmojiHeight
-		m.models.status.Shortcuts = status.GlobalShortcuts(summaryName, authorName)
+		m.models.status.Shortcuts = status.GlobalShortcuts(m.state.KeyMap, summaryName, authorName)
 	case summaryComponent:
 		m.models.header.Focus()
 		m.models.header.SelectSummary()
-		m.models.status.Shortcuts = status.GlobalShortcuts(bodyName, emojiName)
+		m.models.status.Shortcuts = status.GlobalShortcuts(m.state.KeyMap, bodyName, emojiName)
 	case bodyComponent:
 		m.models.body.Focus()
-		m.models.status.Shortcuts = status.GlobalShortcuts(emptyName, summaryName)
+		m.models.status.Shortcuts = status.GlobalShortcuts(m.state.KeyMap, emptyName, summaryName)
 	case helpComponent:
-		m.models.status.Shortcuts = status.HelpShortcuts()
+		m.models.status.Shortcuts = status.HelpShortcuts(m.state.KeyMap)
 		m.models.help.Focus()
 	case optionComponent:
-		m.models.status.Shortcuts = status.OptionShortcuts()
+		m.models.status.Shortcuts = status.OptionShortcuts(m.state.KeyMap)
 		m.models.option.Focus()
 	}
 
@@ -420,9 +547,17 @@ func (m Model) updateModels(msg tea.Msg) (Model, tea.Cmd) {
 	m.models.header, cmds[1] = header.ToModel(m.models.header.Update(msg))
 	m.models.body, cmds[2] = body.ToModel(m.models.body.Update(msg))
 	m.models.footer, cmds[3] = footer.ToModel(m.models.footer.Update(msg))
+	m.models.status.Mode = m.editMode()
 	m.models.status, cmds[4] = status.ToModel(m.models.status.Update(msg))
 	m.models.help, cmds[5] = help.ToModel(m.models.help.Update(msg))
 
+	if _, ok := msg.(colour.Msg); ok {
+		m.models.suggestion, _ = suggestion.ToModel(m.models.suggestion.Update(msg))
+		m.models.generate, _ = suggestion.ToModel(m.models.generate.Update(msg))
+		m.models.fixup, _ = suggestion.ToModel(m.models.fixup.Update(msg))
+		m.models.branch, _ = prompt.ToModel(m.models.branch.Update(msg))
+	}
+
 	if m.focus == optionComponent {
 		m.models.option, cmds[6] = option.ToModel(m.models.option.Update(msg))
 	}
@@ -434,6 +569,17 @@ func (m Model) updateModels(msg tea.Msg) (Model, tea.Cmd) {
 	return m, tea.Batch(cmds...)
 }
 
+func (m Model) editMode() string {
+	switch m.focus {
+	case summaryComponent:
+		return m.models.header.Mode()
+	case bodyComponent:
+		return m.models.body.Mode()
+	}
+
+	return ""
+}
+
 func (m Model) commit(q quit) Model {
 	m.quit = q
 
@@ -447,26 +593,28 @@ func (m Model) commit(q quit) Model {
 	}
 
 	if m.quit == applyQuit {
-		m.models.message = message.New(message.State{
-			Emoji:   emoji,
-			Summary: m.models.header.Summary(),
-			Body:    m.models.body.Value(),
-			Footer:  m.models.footer.Value(),
-			Theme:   m.state.Theme,
-		})
+		m.models.message = message.New(m.messageState(emoji))
 	}
 
 	m.Request = &commit.Request{
-		Author:      m.models.info.Author,
-		Emoji:       emoji,
-		Summary:     m.models.header.Summary(),
-		Body:        m.models.body.Value(),
-		RawBody:     m.models.body.RawValue(),
-		Footer:      m.models.footer.Value(),
-		Amend:       m.amend,
-		DryRun:      m.state.Options.DryRun,
-		File:        m.file,
-		MessageFile: m.state.Options.File.MessageFile,
+		Author:        m.models.info.Author,
+		AuthorDate:    m.state.Identity.AuthorDate,
+		Committer:     m.state.Identity.Committer,
+		CommitterDate: m.state.Identity.CommitterDate,
+		Emoji:         emoji,
+		Summary:       m.models.header.Summary(),
+		Body:          m.models.body.Value(),
+		RawBody:       m.models.body.RawValue(),
+		Footer:        m.models.footer.Value(),
+		Amend:         m.amend,
+		Reword:        m.reword,
+		Branch:        m.models.info.NewBranch,
+		Fixup:         m.models.info.Fixup,
+		Target:        m.models.info.Target,
+		DryRun:        m.state.Options.DryRun,
+		File:          m.file,
+		MessageFile:   m.state.Options.File.MessageFile,
+		Comments:      m.state.File.Comments,
 	}
 
 	if m.writeConfig {
@@ -487,7 +635,30 @@ func (m Model) validate() bool {
 	staged := m.state.Repository.Worktree.IsStaged()
 	summary := m.models.header.Summary()
 
-	return (staged || m.amend) && (summary != "" || m.file)
+	// Fixup and squash commits take the subject of the target.
+	optional := m.file || !m.models.info.Fixup.RequiresSummary()
+
+	return (staged || m.amend || m.reword) && (summary != "" || optional)
+}
+
+// messageState shows the message as committed, with the subject referring to
+// the target of a fixup.
+func (m Model) messageState(emoji string) message.State {
+	ms := message.State{
+		Emoji:   emoji,
+		Summary: m.models.header.Summary(),
+		Body:    m.models.body.Value(),
+		Footer:  m.models.footer.Value(),
+		Theme:   m.state.Theme,
+	}
+
+	// The message replaces the message of the commit being reworded.
+	if f := m.models.info.Fixup; f != commit.FixupUnset && !m.reword {
+		ms.Summary, ms.Body = commit.FixupMessage(f, m.models.info.Target.Message, emoji, ms.Summary, ms.Body)
+		ms.Emoji = ""
+	}
+
+	return ms
 }
 
 func (m *Model) resetCursor() {
@@ -1,15 +1,21 @@
 package ui_test
 
 import (
+	"context"
+	"errors"
 	"fmt"
+	"strings"
 	"testing"
 	"time"
 
 	"github.com/mikelorant/committed/internal/commit"
 	"github.com/mikelorant/committed/internal/config"
 	"github.com/mikelorant/committed/internal/emoji"
+	"github.com/mikelorant/committed/internal/generator"
+	"github.com/mikelorant/committed/internal/keymap"
 	"github.com/mikelorant/committed/internal/repository"
 	"github.com/mikelorant/committed/internal/snapshot"
+	"github.com/mikelorant/committed/internal/spell"
 	"github.com/mikelorant/committed/internal/theme"
 	"github.com/mikelorant/committed/internal/ui"
 	"github.com/mikelorant/committed/internal/ui/uitest"
@@ -251,6 +257,22 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "escape_option_authors_editing",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlO}))
+					for i := 0; i < 13; i++ {
+						m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					}
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRight}))
+					m, _ = ToModel(m.Update(uitest.KeyPress('a')))
+					m, _ = ToModel(uitest.SendString(m, "test"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEscape}))
+					return m
+				},
+			},
+		},
 		{
 			name: "tab_author",
 			args: args{
@@ -377,6 +399,96 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "alt+enter_branch",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyTab}))
+					m, _ = ToModel(uitest.SendString(m, "test"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true}))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m ui.Model) {
+					req := commit.Request{
+						Apply:   true,
+						Summary: "test",
+						Author: repository.User{
+							Name:  "John Doe",
+							Email: "john.doe@example.com",
+						},
+						Branch: "test",
+					}
+
+					assert.Equal(t, &req, m.Request)
+				},
+			},
+		},
+		{
+			name: "alt+enter_fixup",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.History = testHistory()
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true}))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m ui.Model) {
+					req := commit.Request{
+						Apply: true,
+						Author: repository.User{
+							Name:  "John Doe",
+							Email: "john.doe@example.com",
+						},
+						Fixup:  commit.FixupFixup,
+						Target: testHistory()[1],
+					}
+
+					assert.Equal(t, &req, m.Request)
+				},
+			},
+		},
+		{
+			name: "alt+enter_reword",
+			args: args{
+				state: func(s *commit.State) {
+					s.Reword = testHistory()[1]
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter, Alt: true}))
+
+					return m
+				},
+			},
+			want: want{
+				model: func(m ui.Model) {
+					req := commit.Request{
+						Apply:   true,
+						Emoji:   "🎨",
+						Summary: "Split the model",
+						Body:    "Move the views into packages.",
+						RawBody: "Move the views into packages.\n",
+						Reword:  true,
+						Fixup:   commit.FixupAmend,
+						Target:  testHistory()[1],
+					}
+
+					assert.Equal(t, &req, m.Request)
+				},
+			},
+		},
 		{
 			name: "alt+enter_summary_emoji",
 			args: args{
@@ -503,6 +615,497 @@ func TestModel(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "keymap_vim_next",
+			args: args{
+				state: func(s *commit.State) {
+					s.KeyMap, _ = keymap.New(config.Keys{Preset: config.KeyPresetVim})
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'j'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "test"), nil)
+					return m
+				},
+			},
+		},
+		{
+			name: "keymap_custom_signoff",
+			args: args{
+				state: func(s *commit.State) {
+					s.KeyMap, _ = keymap.New(config.Keys{
+						Bindings: map[string]config.KeyList{
+							"signoff": {"ctrl+s"},
+						},
+					})
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'s'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyCtrlS}))
+					return m
+				},
+			},
+		},
+		{
+			name: "vim_summary",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.View.EditMode = config.EditModeVim
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "itest summary"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEscape}))
+					m, _ = ToModel(uitest.SendString(m, "bdw"), nil)
+					return m
+				},
+			},
+		},
+		{
+			name: "vim_body",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.View.EditMode = config.EditModeVim
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "ifirst"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEscape}))
+					m, _ = ToModel(uitest.SendString(m, "yyp"), nil)
+					m, _ = ToModel(uitest.SendString(m, "osecond"), nil)
+					return m
+				},
+			},
+		},
+		{
+			name: "vim_body_substitute",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.View.EditMode = config.EditModeVim
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "test"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyTab}))
+					return m
+				},
+			},
+		},
+		{
+			name: "editor",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(m.Update(ui.EditorMsg{
+						Message: "🎨 summary\n\nbody line one\nbody line two\n",
+					}))
+					return m
+				},
+			},
+		},
+		{
+			name: "editor_summary",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "body"), nil)
+					m, _ = ToModel(m.Update(ui.EditorMsg{
+						Message: "summary\n",
+					}))
+					return m
+				},
+			},
+		},
+		{
+			name: "editor_error",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "body"), nil)
+					m, _ = ToModel(m.Update(ui.EditorMsg{
+						Message: "summary\n",
+						Err:     errMock,
+					}))
+					return m
+				},
+			},
+		},
+		{
+			name: "editor_error_cleared",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(m.Update(ui.EditorMsg{Err: errMock}))
+					m, _ = ToModel(uitest.SendString(m, "body"), nil)
+					return m
+				},
+			},
+		},
+		{
+			name: "reflow",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, strings.Repeat("word ", 20)+"\n- "+strings.Repeat("item ", 20)), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'r'}, Alt: true}))
+					return m
+				},
+			},
+		},
+		{
+			name: "spelling",
+			args: args{
+				state: func(s *commit.State) {
+					s.Spelling = spell.New()
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "fix teh"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}, Alt: true}))
+					return m
+				},
+			},
+		},
+		{
+			name: "spelling_select",
+			args: args{
+				state: func(s *commit.State) {
+					s.Spelling = spell.New()
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "fix teh"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+		},
+		{
+			name: "spelling_summary",
+			args: args{
+				state: func(s *commit.State) {
+					s.Spelling = spell.New()
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "teh"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+		},
+		{
+			name: "spelling_escape",
+			args: args{
+				state: func(s *commit.State) {
+					s.Spelling = spell.New()
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "fix teh"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEsc}))
+					return m
+				},
+			},
+		},
+		{
+			name: "spelling_disabled",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "fix teh"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'z'}, Alt: true}))
+					return m
+				},
+			},
+		},
+		{
+			name: "complete",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.Worktree.Differ = func() string { return "+func testFunction() {\n" }
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "add te"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}, Alt: true}))
+					return m
+				},
+			},
+		},
+		{
+			name: "complete_select",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.Worktree.Differ = func() string { return "+func testFunction() {\n" }
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "add te"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+		},
+		{
+			name: "complete_previous",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.Worktree.Differ = func() string { return "+func testFunction() {\n+func testHelper() {\n" }
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "add te"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'/'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyShiftTab}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+		},
+		{
+			name: "generate",
+			args: args{
+				state: func(s *commit.State) {
+					s.Generators = testGenerators()
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, cmd := ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}, Alt: true}))
+					m, _ = ToModel(m.Update(cmd()))
+					return m
+				},
+			},
+		},
+		{
+			name: "generate_select",
+			args: args{
+				state: func(s *commit.State) {
+					s.Generators = testGenerators()
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, cmd := ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}, Alt: true}))
+					m, _ = ToModel(m.Update(cmd()))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+		},
+		{
+			name: "generate_escape",
+			args: args{
+				state: func(s *commit.State) {
+					s.Generators = testGenerators()
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, cmd := ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}, Alt: true}))
+					m, _ = ToModel(m.Update(cmd()))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEsc}))
+					return m
+				},
+			},
+		},
+		{
+			name: "generate_none",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'g'}, Alt: true}))
+					return m
+				},
+			},
+		},
+		{
+			name: "branch",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "Fix crash on empty config"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}, Alt: true}))
+					return m
+				},
+			},
+		},
+		{
+			name: "branch_select",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "Fix crash on empty config"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+		},
+		{
+			name: "branch_edit",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "Fix crash"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "-now"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+		},
+		{
+			name: "branch_escape",
+			args: args{
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "Fix crash"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEsc}))
+					return m
+				},
+			},
+		},
+		{
+			name: "branch_protected",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.Commit.ProtectedBranches = []string{"master"}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+					return m
+				},
+			},
+		},
+		{
+			name: "branch_protected_new",
+			args: args{
+				state: func(s *commit.State) {
+					s.Config.Commit.ProtectedBranches = []string{"master"}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'3'}, Alt: true}))
+					m, _ = ToModel(uitest.SendString(m, "Fix crash"), nil)
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+		},
+		{
+			name: "fixup",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.History = testHistory()
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}, Alt: true}))
+					return m
+				},
+			},
+		},
+		{
+			name: "fixup_select",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.History = testHistory()
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyDown}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+		},
+		{
+			name: "fixup_squash",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.History = testHistory()
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+		},
+		{
+			name: "fixup_escape",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.History = testHistory()
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEsc}))
+					return m
+				},
+			},
+		},
+		{
+			name: "fixup_remove",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.History = testHistory()
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					return m
+				},
+			},
+		},
+		{
+			name: "fixup_amend",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.History = testHistory()
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'4'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyEnter}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}, Alt: true}))
+					return m
+				},
+			},
+		},
+		{
+			name: "reword",
+			args: args{
+				state: func(s *commit.State) {
+					s.Repository.History = testHistory()
+					s.Reword = testHistory()[1]
+					s.Reword.Author = repository.User{
+						Name:  "Jane Doe",
+						Email: "jane.doe@example.com",
+					}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'a'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'f'}, Alt: true}))
+					m, _ = ToModel(m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{'b'}, Alt: true}))
+					return m
+				},
+			},
+		},
 		{
 			name: "config_author",
 			args: args{
@@ -755,6 +1358,29 @@ func TestModel(t *testing.T) {
 				model: func(m ui.Model) ui.Model {
 					m, _ = ToModel(m.Update(nil))
 
+					return m
+				},
+			},
+		},
+		{
+			name: "snapshot_restore_source_merge",
+			args: args{
+				state: func(s *commit.State) {
+					s.Snapshot = snapshot.Snapshot{
+						Summary: "summary",
+						Body:    "body",
+						Restore: true,
+					}
+					s.Options.Mode = commit.ModeHook
+					s.Options.File.MessageFile = "COMMIT_EDITMSG"
+					s.File = commit.File{
+						Message: "Merge branch 'feature'",
+						Source:  commit.SourceMerge,
+					}
+				},
+				model: func(m ui.Model) ui.Model {
+					m, _ = ToModel(m.Update(nil))
+
 					return m
 				},
 			},
@@ -789,6 +1415,46 @@ func TestModel(t *testing.T) {
 	}
 }
 
+var errMock = errors.New("error")
+
+type MockGenerator struct {
+	suggestions []generator.Suggestion
+	err         error
+}
+
+func (m MockGenerator) Generate(_ context.Context, _ generator.Input) ([]generator.Suggestion, error) {
+	return m.suggestions, m.err
+}
+
+func testGenerators() []generator.Generator {
+	return []generator.Generator{
+		MockGenerator{
+			suggestions: []generator.Suggestion{
+				{Emoji: ":art:", Summary: "Format code"},
+				{Emoji: "🎨", Summary: "Improve structure", Body: "Split the model."},
+			},
+		},
+		MockGenerator{err: errMock},
+	}
+}
+
+func testHistory() []repository.Head {
+	return []repository.Head{
+		{
+			Hash:    "6ecf0ef2c2dffb796033e5a02219af86ec6584e5",
+			Message: "Add the config loader\n",
+		},
+		{
+			Hash:    "918c48b83bd081e863dbe1b80f8998f058cd8294",
+			Message: "🎨 Split the model\n\nMove the views into packages.\n",
+		},
+		{
+			Hash:    "af2d6a6954d532f8ffb47615169c8fdf9d383a1a",
+			Message: "Initial commit\n",
+		},
+	}
+}
+
 func testState() commit.State {
 	return commit.State{
 		Placeholders: commit.Placeholders{
@@ -0,0 +1,253 @@
+package vim
+
+import (
+	"strings"
+	"unicode"
+)
+
+type buffer struct {
+	lines [][]rune
+	row   int
+	col   int
+}
+
+type position struct {
+	row int
+	col int
+}
+
+const (
+	blankClass = iota
+	wordClass
+	punctuationClass
+)
+
+func newBuffer(t Text) *buffer {
+	var b buffer
+
+	b.set(t.Value)
+	b.row = min(max(t.Row, 0), len(b.lines)-1)
+	b.col = min(max(t.Col, 0), len(b.line()))
+
+	return &b
+}
+
+func (b *buffer) text() Text {
+	return Text{
+		Value: b.value(),
+		Row:   b.row,
+		Col:   b.col,
+	}
+}
+
+func (b *buffer) set(str string) {
+	ls := strings.Split(str, "\n")
+
+	b.lines = make([][]rune, len(ls))
+	for i, l := range ls {
+		b.lines[i] = []rune(l)
+	}
+}
+
+func (b *buffer) value() string {
+	ls := make([]string, len(b.lines))
+	for i, l := range b.lines {
+		ls[i] = string(l)
+	}
+
+	return strings.Join(ls, "\n")
+}
+
+func (b *buffer) runes() []rune {
+	return []rune(b.value())
+}
+
+func (b *buffer) line() []rune {
+	return b.lines[b.row]
+}
+
+// clamp keeps the cursor on a character as required by normal mode.
+func (b *buffer) clamp() {
+	b.col = min(b.col, max(len(b.line())-1, 0))
+}
+
+func (b *buffer) firstNonBlank() int {
+	for i, r := range b.line() {
+		if !unicode.IsSpace(r) {
+			return i
+		}
+	}
+
+	return 0
+}
+
+func (b *buffer) offset(row, col int) int {
+	o := col
+	for i := range row {
+		o += len(b.lines[i]) + 1
+	}
+
+	return o
+}
+
+func (b *buffer) setOffset(o int) {
+	o = max(o, 0)
+
+	for i, l := range b.lines {
+		if o <= len(l) || i == len(b.lines)-1 {
+			b.row = i
+			b.col = min(o, len(l))
+
+			return
+		}
+
+		o -= len(l) + 1
+	}
+}
+
+func (b *buffer) slice(start, end int) string {
+	return string(b.runes()[start:end])
+}
+
+func (b *buffer) remove(start, end int) string {
+	rs := b.runes()
+	removed := string(rs[start:end])
+
+	b.set(string(rs[:start]) + string(rs[end:]))
+	b.setOffset(start)
+
+	return removed
+}
+
+func (b *buffer) insert(o int, str string) {
+	rs := b.runes()
+
+	b.set(string(rs[:o]) + str + string(rs[o:]))
+}
+
+func (b *buffer) lineSlice(first, last int) string {
+	ls := make([]string, 0, last-first+1)
+	for _, l := range b.lines[first : last+1] {
+		ls = append(ls, string(l))
+	}
+
+	return strings.Join(ls, "\n")
+}
+
+func (b *buffer) removeLines(first, last int) {
+	b.lines = append(b.lines[:first:first], b.lines[last+1:]...)
+
+	if len(b.lines) == 0 {
+		b.lines = [][]rune{{}}
+	}
+}
+
+func (b *buffer) insertLines(row int, ls []string) {
+	rs := make([][]rune, len(ls))
+	for i, l := range ls {
+		rs[i] = []rune(l)
+	}
+
+	b.lines = append(b.lines[:row:row], append(rs, b.lines[row:]...)...)
+}
+
+// move applies a motion to the cursor and reports if the key was a motion.
+func (b *buffer) move(key string) bool {
+	switch key {
+	case "h", "left", "backspace":
+		b.col = max(b.col-1, 0)
+	case "l", "right", " ":
+		b.col = min(b.col+1, max(len(b.line())-1, 0))
+	case "j", "down":
+		b.row = min(b.row+1, len(b.lines)-1)
+		b.col = min(b.col, len(b.line()))
+	case "k", "up":
+		b.row = max(b.row-1, 0)
+		b.col = min(b.col, len(b.line()))
+	case "0", "home":
+		b.col = 0
+	case "^":
+		b.col = b.firstNonBlank()
+	case "$", "end":
+		b.col = max(len(b.line())-1, 0)
+	case "G":
+		b.row = len(b.lines) - 1
+		b.col = b.firstNonBlank()
+	case "w":
+		b.setOffset(nextWord(b.runes(), b.offset(b.row, b.col)))
+	case "b":
+		b.setOffset(previousWord(b.runes(), b.offset(b.row, b.col)))
+	case "e":
+		b.setOffset(endWord(b.runes(), b.offset(b.row, b.col)))
+	default:
+		return false
+	}
+
+	return true
+}
+
+func nextWord(rs []rune, o int) int {
+	if o >= len(rs) {
+		return o
+	}
+
+	c := class(rs[o])
+	for o < len(rs) && c != blankClass && class(rs[o]) == c {
+		o++
+	}
+
+	for o < len(rs) && class(rs[o]) == blankClass {
+		o++
+	}
+
+	return o
+}
+
+func previousWord(rs []rune, o int) int {
+	o--
+
+	for o > 0 && class(rs[o]) == blankClass {
+		o--
+	}
+
+	if o <= 0 {
+		return 0
+	}
+
+	c := class(rs[o])
+	for o > 0 && class(rs[o-1]) == c {
+		o--
+	}
+
+	return o
+}
+
+func endWord(rs []rune, o int) int {
+	o++
+
+	for o < len(rs) && class(rs[o]) == blankClass {
+		o++
+	}
+
+	if o >= len(rs) {
+		return max(len(rs)-1, 0)
+	}
+
+	c := class(rs[o])
+	for o+1 < len(rs) && class(rs[o+1]) == c {
+		o++
+	}
+
+	return o
+}
+
+func class(r rune) int {
+	switch {
+	case unicode.IsSpace(r):
+		return blankClass
+	case r == '_' || unicode.IsLetter(r) || unicode.IsDigit(r):
+		return wordClass
+	}
+
+	return punctuationClass
+}
@@ -0,0 +1,357 @@
+package vim
+
+import (
+	"strings"
+)
+
+type Mode int
+
+type Editor struct {
+	Mode Mode
+
+	singleLine bool
+	pending    string
+	anchor     position
+	register   register
+	undo       []Text
+}
+
+// Text is the value of an input with the cursor position.
+type Text struct {
+	Value string
+	Row   int
+	Col   int
+}
+
+type register struct {
+	text     string
+	linewise bool
+}
+
+const (
+	ModeNormal Mode = iota
+	ModeInsert
+	ModeVisual
+)
+
+const undoLimit = 100
+
+func New(opts ...func(*Editor)) Editor {
+	var e Editor
+
+	for _, o := range opts {
+		if o != nil {
+			o(&e)
+		}
+	}
+
+	return e
+}
+
+// WithSingleLine restricts the editor to a single line input which disables
+// commands that add lines.
+func WithSingleLine() func(*Editor) {
+	return func(e *Editor) {
+		e.singleLine = true
+	}
+}
+
+func (m Mode) String() string {
+	return []string{
+		"NORMAL",
+		"INSERT",
+		"VISUAL",
+	}[m]
+}
+
+// Update applies the key to the text. Keys that are not handled by the editor
+// return false and should be passed to the input.
+func (e *Editor) Update(key string, t Text) (Text, bool) {
+	switch e.Mode {
+	case ModeInsert:
+		if key != "esc" {
+			return t, false
+		}
+
+		e.Mode = ModeNormal
+		t.Col = max(t.Col-1, 0)
+
+		return t, true
+	case ModeVisual:
+		return e.visual(key, t), true
+	}
+
+	return e.normal(key, t), true
+}
+
+func (e *Editor) normal(key string, t Text) Text {
+	b := newBuffer(t)
+
+	if e.pending != "" {
+		p := e.pending
+		e.pending = ""
+
+		return e.operator(p, key, t, b)
+	}
+
+	if b.move(key) {
+		b.clamp()
+
+		return b.text()
+	}
+
+	switch key {
+	case "d", "c", "y", "g":
+		e.pending = key
+	case "i":
+		e.insert(t)
+	case "a":
+		e.insert(t)
+		b.col = min(b.col+1, len(b.line()))
+	case "I":
+		e.insert(t)
+		b.col = b.firstNonBlank()
+	case "A":
+		e.insert(t)
+		b.col = len(b.line())
+	case "o", "O":
+		if e.singleLine {
+			break
+		}
+
+		e.insert(t)
+
+		if key == "o" {
+			b.row++
+		}
+
+		b.insertLines(b.row, []string{""})
+		b.col = 0
+	case "x", "delete":
+		if len(b.line()) == 0 {
+			break
+		}
+
+		e.push(t)
+		o := b.offset(b.row, b.col)
+		e.register = register{text: b.remove(o, o+1)}
+	case "X":
+		if b.col == 0 {
+			break
+		}
+
+		e.push(t)
+		o := b.offset(b.row, b.col)
+		e.register = register{text: b.remove(o-1, o)}
+	case "s":
+		e.insert(t)
+
+		if len(b.line()) > 0 {
+			o := b.offset(b.row, b.col)
+			e.register = register{text: b.remove(o, o+1)}
+		}
+	case "D":
+		return e.operator("d", "$", t, b)
+	case "C":
+		return e.operator("c", "$", t, b)
+	case "p", "P":
+		e.put(key == "P", t, b)
+	case "u":
+		return e.restore(t)
+	case "v":
+		e.Mode = ModeVisual
+		e.anchor = position{row: b.row, col: b.col}
+	}
+
+	if e.Mode == ModeNormal {
+		b.clamp()
+	}
+
+	return b.text()
+}
+
+func (e *Editor) visual(key string, t Text) Text {
+	b := newBuffer(t)
+
+	if b.move(key) {
+		b.clamp()
+
+		return b.text()
+	}
+
+	start := b.offset(e.anchor.row, e.anchor.col)
+	end := b.offset(b.row, b.col)
+	start, end = min(start, end), max(start, end)+1
+	end = min(end, len(b.runes()))
+
+	switch key {
+	case "esc", "v":
+		e.Mode = ModeNormal
+	case "y":
+		e.Mode = ModeNormal
+		e.register = register{text: b.slice(start, end)}
+		b.setOffset(start)
+	case "d", "x":
+		e.Mode = ModeNormal
+		e.push(t)
+		e.register = register{text: b.remove(start, end)}
+	case "c":
+		e.insert(t)
+		e.register = register{text: b.remove(start, end)}
+	}
+
+	if e.Mode == ModeNormal {
+		b.clamp()
+	}
+
+	return b.text()
+}
+
+// operator applies the pending operator with the motion of the key. Repeating
+// the operator applies it to the whole line.
+func (e *Editor) operator(op, key string, t Text, b *buffer) Text {
+	if op == "g" {
+		if key == "g" {
+			b.row = 0
+			b.col = b.firstNonBlank()
+		}
+
+		return b.text()
+	}
+
+	first, last := b.row, b.row
+
+	switch key {
+	case op:
+	case "j", "down":
+		last = min(b.row+1, len(b.lines)-1)
+	case "k", "up":
+		first = max(b.row-1, 0)
+	default:
+		return e.charOperator(op, key, t, b)
+	}
+
+	e.register = register{text: b.lineSlice(first, last), linewise: true}
+
+	switch op {
+	case "d":
+		e.push(t)
+		b.removeLines(first, last)
+		b.row = min(first, len(b.lines)-1)
+		b.col = b.firstNonBlank()
+	case "c":
+		e.insert(t)
+		b.removeLines(first, last)
+		b.insertLines(first, []string{""})
+		b.row = first
+		b.col = 0
+	case "y":
+		b.row = first
+	}
+
+	return b.text()
+}
+
+func (e *Editor) charOperator(op, key string, t Text, b *buffer) Text {
+	target := *b
+
+	if !target.move(key) {
+		return b.text()
+	}
+
+	// Motions that cross lines only apply to the current line.
+	switch {
+	case target.row > b.row:
+		target.row, target.col = b.row, len(b.line())
+	case target.row < b.row:
+		target.row, target.col = b.row, 0
+	}
+
+	start, end := min(b.col, target.col), max(b.col, target.col)
+	if key == "e" || key == "$" || key == "end" {
+		end++
+	}
+	end = min(end, len(b.line()))
+
+	so, eo := b.offset(b.row, start), b.offset(b.row, end)
+
+	switch op {
+	case "d":
+		e.push(t)
+		e.register = register{text: b.remove(so, eo)}
+		b.clamp()
+	case "c":
+		e.insert(t)
+		e.register = register{text: b.remove(so, eo)}
+	case "y":
+		e.register = register{text: b.slice(so, eo)}
+		b.col = start
+	}
+
+	return b.text()
+}
+
+func (e *Editor) put(before bool, t Text, b *buffer) {
+	if e.register.text == "" {
+		return
+	}
+
+	e.push(t)
+
+	if e.register.linewise && !e.singleLine {
+		row := b.row
+		if !before {
+			row++
+		}
+
+		b.insertLines(row, strings.Split(e.register.text, "\n"))
+		b.row = row
+		b.col = b.firstNonBlank()
+
+		return
+	}
+
+	text := strings.ReplaceAll(e.register.text, "\n", " ")
+
+	col := b.col
+	if !before {
+		col = min(col+1, len(b.line()))
+	}
+
+	o := b.offset(b.row, col)
+	b.insert(o, text)
+	b.setOffset(o + len([]rune(text)) - 1)
+}
+
+// insert changes to insert mode. All changes made until returning to normal
+// mode are undone together.
+func (e *Editor) insert(t Text) {
+	e.Mode = ModeInsert
+	e.push(t)
+}
+
+func (e *Editor) push(t Text) {
+	e.undo = append(e.undo, t)
+
+	if len(e.undo) > undoLimit {
+		e.undo = e.undo[1:]
+	}
+}
+
+func (e *Editor) restore(t Text) Text {
+	for len(e.undo) > 0 {
+		prev := e.undo[len(e.undo)-1]
+		e.undo = e.undo[:len(e.undo)-1]
+
+		if prev.Value == t.Value {
+			continue
+		}
+
+		b := newBuffer(prev)
+		b.clamp()
+
+		return b.text()
+	}
+
+	return t
+}
@@ -0,0 +1,540 @@
+package vim_test
+
+import (
+	"testing"
+
+	"github.com/mikelorant/committed/internal/ui/vim"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpdate(t *testing.T) {
+	t.Parallel()
+
+	type args struct {
+		text   vim.Text
+		keys   []string
+		single bool
+	}
+
+	type want struct {
+		text    vim.Text
+		mode    vim.Mode
+		handled []bool
+	}
+
+	tests := []struct {
+		name string
+		args args
+		want want
+	}{
+		{
+			name: "empty",
+			want: want{
+				text: vim.Text{},
+			},
+		},
+		{
+			name: "motion_left_right",
+			args: args{
+				text: vim.Text{Value: "hello"},
+				keys: []string{"l", "l", "l", "h"},
+			},
+			want: want{
+				text: vim.Text{Value: "hello", Col: 2},
+			},
+		},
+		{
+			name: "motion_right_limit",
+			args: args{
+				text: vim.Text{Value: "hi"},
+				keys: []string{"l", "l", "l"},
+			},
+			want: want{
+				text: vim.Text{Value: "hi", Col: 1},
+			},
+		},
+		{
+			name: "motion_down_up",
+			args: args{
+				text: vim.Text{Value: "first line\nsecond\nthird line", Col: 8},
+				keys: []string{"j", "j", "k"},
+			},
+			want: want{
+				text: vim.Text{Value: "first line\nsecond\nthird line", Row: 1, Col: 5},
+			},
+		},
+		{
+			name: "motion_line",
+			args: args{
+				text: vim.Text{Value: "  hello world", Col: 5},
+				keys: []string{"$"},
+			},
+			want: want{
+				text: vim.Text{Value: "  hello world", Col: 12},
+			},
+		},
+		{
+			name: "motion_first_non_blank",
+			args: args{
+				text: vim.Text{Value: "  hello world", Col: 8},
+				keys: []string{"^"},
+			},
+			want: want{
+				text: vim.Text{Value: "  hello world", Col: 2},
+			},
+		},
+		{
+			name: "motion_word",
+			args: args{
+				text: vim.Text{Value: "one two.three\nfour"},
+				keys: []string{"w", "w", "w", "w"},
+			},
+			want: want{
+				text: vim.Text{Value: "one two.three\nfour", Row: 1},
+			},
+		},
+		{
+			name: "motion_word_back",
+			args: args{
+				text: vim.Text{Value: "one two\nthree", Row: 1, Col: 3},
+				keys: []string{"b", "b"},
+			},
+			want: want{
+				text: vim.Text{Value: "one two\nthree", Col: 4},
+			},
+		},
+		{
+			name: "motion_word_end",
+			args: args{
+				text: vim.Text{Value: "one two"},
+				keys: []string{"e", "e"},
+			},
+			want: want{
+				text: vim.Text{Value: "one two", Col: 6},
+			},
+		},
+		{
+			name: "motion_top_bottom",
+			args: args{
+				text: vim.Text{Value: "one\n  two\nthree"},
+				keys: []string{"G", "k", "g", "g"},
+			},
+			want: want{
+				text: vim.Text{Value: "one\n  two\nthree"},
+			},
+		},
+		{
+			name: "insert",
+			args: args{
+				text: vim.Text{Value: "hello", Col: 2},
+				keys: []string{"i", "x"},
+			},
+			want: want{
+				text:    vim.Text{Value: "hello", Col: 2},
+				mode:    vim.ModeInsert,
+				handled: []bool{true, false},
+			},
+		},
+		{
+			name: "append",
+			args: args{
+				text: vim.Text{Value: "hello", Col: 2},
+				keys: []string{"a"},
+			},
+			want: want{
+				text: vim.Text{Value: "hello", Col: 3},
+				mode: vim.ModeInsert,
+			},
+		},
+		{
+			name: "append_line",
+			args: args{
+				text: vim.Text{Value: "hello"},
+				keys: []string{"A"},
+			},
+			want: want{
+				text: vim.Text{Value: "hello", Col: 5},
+				mode: vim.ModeInsert,
+			},
+		},
+		{
+			name: "insert_line",
+			args: args{
+				text: vim.Text{Value: "  hello", Col: 5},
+				keys: []string{"I"},
+			},
+			want: want{
+				text: vim.Text{Value: "  hello", Col: 2},
+				mode: vim.ModeInsert,
+			},
+		},
+		{
+			name: "insert_escape",
+			args: args{
+				text: vim.Text{Value: "hello", Col: 3},
+				keys: []string{"i", "esc"},
+			},
+			want: want{
+				text: vim.Text{Value: "hello", Col: 2},
+			},
+		},
+		{
+			name: "open_below",
+			args: args{
+				text: vim.Text{Value: "one\ntwo"},
+				keys: []string{"o"},
+			},
+			want: want{
+				text: vim.Text{Value: "one\n\ntwo", Row: 1},
+				mode: vim.ModeInsert,
+			},
+		},
+		{
+			name: "open_above",
+			args: args{
+				text: vim.Text{Value: "one\ntwo", Row: 1},
+				keys: []string{"O"},
+			},
+			want: want{
+				text: vim.Text{Value: "one\n\ntwo", Row: 1},
+				mode: vim.ModeInsert,
+			},
+		},
+		{
+			name: "open_single_line",
+			args: args{
+				text:   vim.Text{Value: "one"},
+				keys:   []string{"o"},
+				single: true,
+			},
+			want: want{
+				text: vim.Text{Value: "one"},
+			},
+		},
+		{
+			name: "delete_character",
+			args: args{
+				text: vim.Text{Value: "hello", Col: 4},
+				keys: []string{"x"},
+			},
+			want: want{
+				text: vim.Text{Value: "hell", Col: 3},
+			},
+		},
+		{
+			name: "delete_line",
+			args: args{
+				text: vim.Text{Value: "one\ntwo\nthree", Row: 1, Col: 2},
+				keys: []string{"d", "d"},
+			},
+			want: want{
+				text: vim.Text{Value: "one\nthree", Row: 1},
+			},
+		},
+		{
+			name: "delete_last_line",
+			args: args{
+				text: vim.Text{Value: "one\ntwo", Row: 1},
+				keys: []string{"d", "d"},
+			},
+			want: want{
+				text: vim.Text{Value: "one"},
+			},
+		},
+		{
+			name: "delete_only_line",
+			args: args{
+				text: vim.Text{Value: "one"},
+				keys: []string{"d", "d"},
+			},
+			want: want{
+				text: vim.Text{},
+			},
+		},
+		{
+			name: "delete_lines_down",
+			args: args{
+				text: vim.Text{Value: "one\ntwo\nthree"},
+				keys: []string{"d", "j"},
+			},
+			want: want{
+				text: vim.Text{Value: "three"},
+			},
+		},
+		{
+			name: "delete_word",
+			args: args{
+				text: vim.Text{Value: "one two three", Col: 4},
+				keys: []string{"d", "w"},
+			},
+			want: want{
+				text: vim.Text{Value: "one three", Col: 4},
+			},
+		},
+		{
+			name: "delete_last_word",
+			args: args{
+				text: vim.Text{Value: "one two\nthree", Col: 4},
+				keys: []string{"d", "w"},
+			},
+			want: want{
+				text: vim.Text{Value: "one \nthree", Col: 3},
+			},
+		},
+		{
+			name: "delete_end_of_line",
+			args: args{
+				text: vim.Text{Value: "one two", Col: 3},
+				keys: []string{"D"},
+			},
+			want: want{
+				text: vim.Text{Value: "one", Col: 2},
+			},
+		},
+		{
+			name: "change_word",
+			args: args{
+				text: vim.Text{Value: "one two", Col: 4},
+				keys: []string{"c", "e"},
+			},
+			want: want{
+				text: vim.Text{Value: "one ", Col: 4},
+				mode: vim.ModeInsert,
+			},
+		},
+		{
+			name: "change_line",
+			args: args{
+				text: vim.Text{Value: "one\ntwo", Row: 1, Col: 1},
+				keys: []string{"c", "c"},
+			},
+			want: want{
+				text: vim.Text{Value: "one\n", Row: 1},
+				mode: vim.ModeInsert,
+			},
+		},
+		{
+			name: "yank_put_line",
+			args: args{
+				text: vim.Text{Value: "one\ntwo"},
+				keys: []string{"y", "y", "j", "p"},
+			},
+			want: want{
+				text: vim.Text{Value: "one\ntwo\none", Row: 2},
+			},
+		},
+		{
+			name: "yank_put_line_before",
+			args: args{
+				text: vim.Text{Value: "one\ntwo", Row: 1},
+				keys: []string{"y", "y", "P"},
+			},
+			want: want{
+				text: vim.Text{Value: "one\ntwo\ntwo", Row: 1},
+			},
+		},
+		{
+			name: "yank_put_line_single_line",
+			args: args{
+				text:   vim.Text{Value: "one"},
+				keys:   []string{"y", "y", "$", "p"},
+				single: true,
+			},
+			want: want{
+				text: vim.Text{Value: "oneone", Col: 5},
+			},
+		},
+		{
+			name: "yank_put_word",
+			args: args{
+				text: vim.Text{Value: "one two"},
+				keys: []string{"y", "w", "$", "p"},
+			},
+			want: want{
+				text: vim.Text{Value: "one twoone ", Col: 10},
+			},
+		},
+		{
+			name: "delete_put",
+			args: args{
+				text: vim.Text{Value: "ab"},
+				keys: []string{"x", "p"},
+			},
+			want: want{
+				text: vim.Text{Value: "ba", Col: 1},
+			},
+		},
+		{
+			name: "put_empty_register",
+			args: args{
+				text: vim.Text{Value: "ab"},
+				keys: []string{"p"},
+			},
+			want: want{
+				text: vim.Text{Value: "ab"},
+			},
+		},
+		{
+			name: "undo",
+			args: args{
+				text: vim.Text{Value: "one two", Col: 4},
+				keys: []string{"d", "w", "x", "u"},
+			},
+			want: want{
+				text: vim.Text{Value: "one ", Col: 3},
+			},
+		},
+		{
+			name: "undo_all",
+			args: args{
+				text: vim.Text{Value: "one two", Col: 4},
+				keys: []string{"d", "w", "x", "u", "u", "u"},
+			},
+			want: want{
+				text: vim.Text{Value: "one two", Col: 4},
+			},
+		},
+		{
+			name: "undo_insert",
+			args: args{
+				text: vim.Text{Value: "one"},
+				keys: []string{"A"},
+			},
+			want: want{
+				text: vim.Text{Value: "one", Col: 3},
+				mode: vim.ModeInsert,
+			},
+		},
+		{
+			name: "visual_yank",
+			args: args{
+				text: vim.Text{Value: "one two"},
+				keys: []string{"v", "l", "l", "y", "$", "p"},
+			},
+			want: want{
+				text: vim.Text{Value: "one twoone", Col: 9},
+			},
+		},
+		{
+			name: "visual_delete",
+			args: args{
+				text: vim.Text{Value: "one\ntwo", Col: 2},
+				keys: []string{"v", "j", "d"},
+			},
+			want: want{
+				text: vim.Text{Value: "on", Col: 1},
+			},
+		},
+		{
+			name: "visual_change",
+			args: args{
+				text: vim.Text{Value: "one two", Col: 4},
+				keys: []string{"v", "e", "c"},
+			},
+			want: want{
+				text: vim.Text{Value: "one ", Col: 4},
+				mode: vim.ModeInsert,
+			},
+		},
+		{
+			name: "visual_escape",
+			args: args{
+				text: vim.Text{Value: "one two"},
+				keys: []string{"v", "w", "esc"},
+			},
+			want: want{
+				text: vim.Text{Value: "one two", Col: 4},
+			},
+		},
+		{
+			name: "visual_mode",
+			args: args{
+				text: vim.Text{Value: "one two"},
+				keys: []string{"v"},
+			},
+			want: want{
+				text: vim.Text{Value: "one two"},
+				mode: vim.ModeVisual,
+			},
+		},
+		{
+			name: "pending_escape",
+			args: args{
+				text: vim.Text{Value: "one two"},
+				keys: []string{"d", "esc", "x"},
+			},
+			want: want{
+				text: vim.Text{Value: "ne two"},
+			},
+		},
+		{
+			name: "ignored",
+			args: args{
+				text: vim.Text{Value: "one"},
+				keys: []string{"z", "tab", "ctrl+a"},
+			},
+			want: want{
+				text:    vim.Text{Value: "one"},
+				handled: []bool{true, true, true},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			var opts []func(*vim.Editor)
+			if tt.args.single {
+				opts = append(opts, vim.WithSingleLine())
+			}
+
+			e := vim.New(opts...)
+
+			text := tt.args.text
+
+			var handled []bool
+
+			for _, k := range tt.args.keys {
+				var ok bool
+
+				text, ok = e.Update(k, text)
+				handled = append(handled, ok)
+			}
+
+			assert.Equal(t, tt.want.text, text)
+			assert.Equal(t, tt.want.mode, e.Mode)
+
+			if tt.want.handled != nil {
+				assert.Equal(t, tt.want.handled, handled)
+			}
+		})
+	}
+}
+
+func TestModeString(t *testing.T) {
+	t.Parallel()
+
+	tests := []struct {
+		name string
+		mode vim.Mode
+		want string
+	}{
+		{name: "normal", mode: vim.ModeNormal, want: "NORMAL"},
+		{name: "insert", mode: vim.ModeInsert, want: "INSERT"},
+		{name: "visual", mode: vim.ModeVisual, want: "VISUAL"},
+	}
+
+	for _, tt := range tests {
+		tt := tt
+
+		t.Run(tt.name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tt.want, tt.mode.String())
+		})
+	}
+}